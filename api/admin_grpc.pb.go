@@ -24,6 +24,15 @@ const _ = grpc.SupportPackageIsVersion7
 type AdminClient interface {
 	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error)
 	Prune(ctx context.Context, in *PruneRequest, opts ...grpc.CallOption) (*PruneResponse, error)
+	// SetSchema binds a JSON Schema to a key prefix, in the request's
+	// namespace. Values set on keys with the prefix must validate against
+	// the schema. If a schema is already bound to the prefix, it's replaced.
+	SetSchema(ctx context.Context, in *SetSchemaRequest, opts ...grpc.CallOption) (*SetSchemaResponse, error)
+	// DeleteSchema removes the JSON Schema bound to a key prefix
+	DeleteSchema(ctx context.Context, in *DeleteSchemaRequest, opts ...grpc.CallOption) (*DeleteSchemaResponse, error)
+	// ListSchemas returns the JSON Schemas bound to key prefixes in the
+	// request's namespace
+	ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error)
 }
 
 type adminClient struct {
@@ -52,12 +61,48 @@ func (c *adminClient) Prune(ctx context.Context, in *PruneRequest, opts ...grpc.
 	return out, nil
 }
 
+func (c *adminClient) SetSchema(ctx context.Context, in *SetSchemaRequest, opts ...grpc.CallOption) (*SetSchemaResponse, error) {
+	out := new(SetSchemaResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.Admin/SetSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeleteSchema(ctx context.Context, in *DeleteSchemaRequest, opts ...grpc.CallOption) (*DeleteSchemaResponse, error) {
+	out := new(DeleteSchemaResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.Admin/DeleteSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListSchemas(ctx context.Context, in *ListSchemasRequest, opts ...grpc.CallOption) (*ListSchemasResponse, error) {
+	out := new(ListSchemasResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.Admin/ListSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 // All implementations must embed UnimplementedAdminServer
 // for forward compatibility
 type AdminServer interface {
 	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
 	Prune(context.Context, *PruneRequest) (*PruneResponse, error)
+	// SetSchema binds a JSON Schema to a key prefix, in the request's
+	// namespace. Values set on keys with the prefix must validate against
+	// the schema. If a schema is already bound to the prefix, it's replaced.
+	SetSchema(context.Context, *SetSchemaRequest) (*SetSchemaResponse, error)
+	// DeleteSchema removes the JSON Schema bound to a key prefix
+	DeleteSchema(context.Context, *DeleteSchemaRequest) (*DeleteSchemaResponse, error)
+	// ListSchemas returns the JSON Schemas bound to key prefixes in the
+	// request's namespace
+	ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error)
 	mustEmbedUnimplementedAdminServer()
 }
 
@@ -71,6 +116,15 @@ func (UnimplementedAdminServer) Shutdown(context.Context, *ShutdownRequest) (*Sh
 func (UnimplementedAdminServer) Prune(context.Context, *PruneRequest) (*PruneResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Prune not implemented")
 }
+func (UnimplementedAdminServer) SetSchema(context.Context, *SetSchemaRequest) (*SetSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSchema not implemented")
+}
+func (UnimplementedAdminServer) DeleteSchema(context.Context, *DeleteSchemaRequest) (*DeleteSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchema not implemented")
+}
+func (UnimplementedAdminServer) ListSchemas(context.Context, *ListSchemasRequest) (*ListSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSchemas not implemented")
+}
 func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
 
 // UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +174,60 @@ func _Admin_Prune_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_SetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.Admin/SetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetSchema(ctx, req.(*SetSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DeleteSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DeleteSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.Admin/DeleteSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DeleteSchema(ctx, req.(*DeleteSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.Admin/ListSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListSchemas(ctx, req.(*ListSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +243,18 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Prune",
 			Handler:    _Admin_Prune_Handler,
 		},
+		{
+			MethodName: "SetSchema",
+			Handler:    _Admin_SetSchema_Handler,
+		},
+		{
+			MethodName: "DeleteSchema",
+			Handler:    _Admin_DeleteSchema_Handler,
+		},
+		{
+			MethodName: "ListSchemas",
+			Handler:    _Admin_ListSchemas_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/admin.proto",
@@ -26,7 +26,8 @@ type KeyQuarryClient interface {
 	// it will be updated. If the key already exists and is
 	// locked, an error will be returned. If the key already
 	// exists and is expired (and not locked), it will be deleted
-	// and created as new.
+	// and created as new. If a WriteMode is provided and its
+	// condition isn't met, a FailedPrecondition error will be returned.
 	Set(ctx context.Context, in *KeyValue, opts ...grpc.CallOption) (*SetResponse, error)
 	// Get returns the value of a key. If the key does not exist,
 	// an error will be returned.
@@ -36,7 +37,8 @@ type KeyQuarryClient interface {
 	Inspect(ctx context.Context, in *InspectRequest, opts ...grpc.CallOption) (*InspectResponse, error)
 	// Delete deletes a key. If the key does not exist, an error
 	// will be returned. If the key is locked, an error will
-	// be returned.
+	// be returned. If a WriteMode is provided and its condition
+	// isn't met, a FailedPrecondition error will be returned.
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 	// Exists indicates whether a key exists.
 	Exists(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ExistsResponse, error)
@@ -45,7 +47,9 @@ type KeyQuarryClient interface {
 	Pop(ctx context.Context, in *PopRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	// Clear deletes all unlocked keys from the store.
 	Clear(ctx context.Context, in *ClearRequest, opts ...grpc.CallOption) (*ClearResponse, error)
-	// ListKeys returns a list of keys matching a pattern.
+	// ListKeys returns a sorted list of keys matching a pattern and/or
+	// prefix. If a limit is provided and there are more matching keys,
+	// next_page_token can be used to retrieve the next page.
 	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysResponse, error)
 	// Stats returns statistics about the store.
 	Stats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*ServerMetrics, error)
@@ -61,10 +65,125 @@ type KeyQuarryClient interface {
 	// its timestamp
 	GetRevision(ctx context.Context, in *GetRevisionRequest, opts ...grpc.CallOption) (*RevisionResponse, error)
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	// KeepAlive renews the given lease each time a request is received,
+	// responding with its new expiration time
+	KeepAlive(ctx context.Context, opts ...grpc.CallOption) (KeyQuarry_KeepAliveClient, error)
 	SetReadOnly(ctx context.Context, in *ReadOnlyRequest, opts ...grpc.CallOption) (*ReadOnlyResponse, error)
 	WatchStream(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (KeyQuarry_WatchStreamClient, error)
 	GetKeyMetric(ctx context.Context, in *KeyMetricRequest, opts ...grpc.CallOption) (*KeyMetric, error)
 	WatchKeyValue(ctx context.Context, in *WatchKeyValueRequest, opts ...grpc.CallOption) (KeyQuarry_WatchKeyValueClient, error)
+	// Txn applies a list of operations atomically, if all of its guards
+	// are met. Either every operation is applied, or none are. If a guard
+	// isn't met, a FailedPrecondition error will be returned.
+	Txn(ctx context.Context, in *TxnRequest, opts ...grpc.CallOption) (*TxnResponse, error)
+	// BatchGet gets the values of multiple keys, with a result (or error)
+	// for each key.
+	BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error)
+	// BatchSet sets multiple keys, with a result (or error) for each key.
+	// Unlike Txn, items are applied independently, so an error for one
+	// item doesn't prevent the others from being set.
+	BatchSet(ctx context.Context, in *BatchSetRequest, opts ...grpc.CallOption) (*BatchSetResponse, error)
+	// BatchDelete deletes multiple keys, with a result (or error) for
+	// each key.
+	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteResponse, error)
+	// Increment atomically adds an amount to the value of a key, treating
+	// it as a signed 64-bit integer, and returns the new value. If the key
+	// doesn't exist, it will be created. A negative amount decrements
+	// the value.
+	Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error)
+	// Scan streams the keys in a range and/or with a prefix, sorted
+	// lexicographically, along with their values and metadata. Results
+	// reflect the state of the store at the time of the request. Keys
+	// locked by another client are skipped, unless include_locked is set.
+	Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (KeyQuarry_ScanClient, error)
+	// Rename atomically moves a key's value, content type, lifespan and
+	// (optionally) revision history to a new key. If the destination
+	// exists, an error will be returned unless overwrite is set. If either
+	// key is locked by another client, an error will be returned.
+	Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*RenameResponse, error)
+	// Copy atomically duplicates a key's value, content type, lifespan and
+	// (optionally) revision history to a new key, with the same conditions
+	// as Rename.
+	Copy(ctx context.Context, in *CopyRequest, opts ...grpc.CallOption) (*CopyResponse, error)
+	// Persist removes a key's lifespan, so it won't expire, without
+	// changing its value. If the key is locked by another client, an
+	// error will be returned.
+	Persist(ctx context.Context, in *PersistRequest, opts ...grpc.CallOption) (*PersistResponse, error)
+	// Campaign waits until the client becomes leader of the named election,
+	// then publishes the given value as the leader's value. Leadership is
+	// held with a lock on the election, which lapses after the lease
+	// duration unless the leader renews it by campaigning again.
+	Campaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*CampaignResponse, error)
+	// Resign gives up leadership of the named election, if held by the client
+	Resign(ctx context.Context, in *ResignRequest, opts ...grpc.CallOption) (*ResignResponse, error)
+	// Leader returns the current leader of the named election
+	Leader(ctx context.Context, in *LeaderRequest, opts ...grpc.CallOption) (*LeaderResponse, error)
+	// ObserveLeader streams the leader of the named election each time
+	// it changes, starting with the current leader
+	ObserveLeader(ctx context.Context, in *LeaderRequest, opts ...grpc.CallOption) (KeyQuarry_ObserveLeaderClient, error)
+	// AcquireSemaphore acquires (or renews) one of the permits of the
+	// semaphore on a key, creating the key if it doesn't exist. Each permit
+	// is released after its TTL, unless renewed.
+	AcquireSemaphore(ctx context.Context, in *AcquireSemaphoreRequest, opts ...grpc.CallOption) (*AcquireSemaphoreResponse, error)
+	// ReleaseSemaphore releases the client's permit for a semaphore
+	ReleaseSemaphore(ctx context.Context, in *ReleaseSemaphoreRequest, opts ...grpc.CallOption) (*ReleaseSemaphoreResponse, error)
+	// ListPush adds values to the start or end of a list, creating the
+	// key if it doesn't exist
+	ListPush(ctx context.Context, in *ListPushRequest, opts ...grpc.CallOption) (*ListPushResponse, error)
+	// ListPop removes and returns the value at the start or end of a list,
+	// optionally waiting for a value to be pushed if the list is empty
+	ListPop(ctx context.Context, in *ListPopRequest, opts ...grpc.CallOption) (*ListPopResponse, error)
+	// ListRange returns the values of a list between two indexes
+	ListRange(ctx context.Context, in *ListRangeRequest, opts ...grpc.CallOption) (*ListRangeResponse, error)
+	// ListLen returns the number of values in a list
+	ListLen(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ListLenResponse, error)
+	// HSet sets fields of a hash, creating the key if it doesn't exist
+	HSet(ctx context.Context, in *HSetRequest, opts ...grpc.CallOption) (*HSetResponse, error)
+	// HGet returns the value of a field of a hash
+	HGet(ctx context.Context, in *HGetRequest, opts ...grpc.CallOption) (*HGetResponse, error)
+	// HDel deletes fields from a hash
+	HDel(ctx context.Context, in *HDelRequest, opts ...grpc.CallOption) (*HDelResponse, error)
+	// HGetAll returns all the fields of a hash
+	HGetAll(ctx context.Context, in *Key, opts ...grpc.CallOption) (*HGetAllResponse, error)
+	// HIncrBy adds to the integer value of a field of a hash, creating
+	// the key and field if they don't exist
+	HIncrBy(ctx context.Context, in *HIncrByRequest, opts ...grpc.CallOption) (*HIncrByResponse, error)
+	// SAdd adds members to a set, creating the key if it doesn't exist
+	SAdd(ctx context.Context, in *SetMembersRequest, opts ...grpc.CallOption) (*SAddResponse, error)
+	// SRem removes members from a set
+	SRem(ctx context.Context, in *SetMembersRequest, opts ...grpc.CallOption) (*SRemResponse, error)
+	// SMembers returns the members of a set
+	SMembers(ctx context.Context, in *Key, opts ...grpc.CallOption) (*SMembersResponse, error)
+	// SIsMember returns whether a value is a member of a set
+	SIsMember(ctx context.Context, in *SIsMemberRequest, opts ...grpc.CallOption) (*SIsMemberResponse, error)
+	// ZAdd adds members to a sorted set (or updates their scores),
+	// creating the key if it doesn't exist
+	ZAdd(ctx context.Context, in *ZAddRequest, opts ...grpc.CallOption) (*ZAddResponse, error)
+	// ZRangeByScore returns the members of a sorted set with scores
+	// between a minimum and maximum, ordered by score
+	ZRangeByScore(ctx context.Context, in *ZRangeByScoreRequest, opts ...grpc.CallOption) (*ZMembersResponse, error)
+	// ZRem removes members from a sorted set
+	ZRem(ctx context.Context, in *SetMembersRequest, opts ...grpc.CallOption) (*ZRemResponse, error)
+	// ZPopMin removes and returns the members of a sorted set with the
+	// lowest scores
+	ZPopMin(ctx context.Context, in *ZPopMinRequest, opts ...grpc.CallOption) (*ZMembersResponse, error)
+	// Publish sends a message to the subscribers of a topic. Messages
+	// aren't stored, so are only received by current subscribers.
+	Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error)
+	// SubscribeTopic streams messages published to topics matching any
+	// of the given glob patterns
+	SubscribeTopic(ctx context.Context, in *SubscribeTopicRequest, opts ...grpc.CallOption) (KeyQuarry_SubscribeTopicClient, error)
+	// JSONGet returns the part of a JSON value selected by a JSONPath
+	// expression
+	JSONGet(ctx context.Context, in *JSONGetRequest, opts ...grpc.CallOption) (*JSONGetResponse, error)
+	// JSONPatch atomically applies a JSON Patch (RFC 6902) to a JSON value
+	JSONPatch(ctx context.Context, in *JSONPatchRequest, opts ...grpc.CallOption) (*JSONPatchResponse, error)
+	// ListRevisions returns the metadata of each of the revisions of a key
+	// held in its history, oldest first
+	ListRevisions(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ListRevisionsResponse, error)
+	// Rollback sets the value of a key to the value of one of its
+	// revisions, as a new version. The same conditions as Set apply.
+	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
 }
 
 type keyQuarryClient struct {
@@ -201,6 +320,37 @@ func (c *keyQuarryClient) Register(ctx context.Context, in *RegisterRequest, opt
 	return out, nil
 }
 
+func (c *keyQuarryClient) KeepAlive(ctx context.Context, opts ...grpc.CallOption) (KeyQuarry_KeepAliveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KeyQuarry_ServiceDesc.Streams[0], "/keyquarry.KeyQuarry/KeepAlive", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keyQuarryKeepAliveClient{stream}
+	return x, nil
+}
+
+type KeyQuarry_KeepAliveClient interface {
+	Send(*KeepAliveRequest) error
+	Recv() (*KeepAliveResponse, error)
+	grpc.ClientStream
+}
+
+type keyQuarryKeepAliveClient struct {
+	grpc.ClientStream
+}
+
+func (x *keyQuarryKeepAliveClient) Send(m *KeepAliveRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *keyQuarryKeepAliveClient) Recv() (*KeepAliveResponse, error) {
+	m := new(KeepAliveResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *keyQuarryClient) SetReadOnly(ctx context.Context, in *ReadOnlyRequest, opts ...grpc.CallOption) (*ReadOnlyResponse, error) {
 	out := new(ReadOnlyResponse)
 	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/SetReadOnly", in, out, opts...)
@@ -211,7 +361,7 @@ func (c *keyQuarryClient) SetReadOnly(ctx context.Context, in *ReadOnlyRequest,
 }
 
 func (c *keyQuarryClient) WatchStream(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (KeyQuarry_WatchStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &KeyQuarry_ServiceDesc.Streams[0], "/keyquarry.KeyQuarry/WatchStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &KeyQuarry_ServiceDesc.Streams[1], "/keyquarry.KeyQuarry/WatchStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -252,7 +402,7 @@ func (c *keyQuarryClient) GetKeyMetric(ctx context.Context, in *KeyMetricRequest
 }
 
 func (c *keyQuarryClient) WatchKeyValue(ctx context.Context, in *WatchKeyValueRequest, opts ...grpc.CallOption) (KeyQuarry_WatchKeyValueClient, error) {
-	stream, err := c.cc.NewStream(ctx, &KeyQuarry_ServiceDesc.Streams[1], "/keyquarry.KeyQuarry/WatchKeyValue", opts...)
+	stream, err := c.cc.NewStream(ctx, &KeyQuarry_ServiceDesc.Streams[2], "/keyquarry.KeyQuarry/WatchKeyValue", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -283,455 +433,1821 @@ func (x *keyQuarryWatchKeyValueClient) Recv() (*WatchKeyValueResponse, error) {
 	return m, nil
 }
 
-// KeyQuarryServer is the server API for KeyQuarry service.
-// All implementations must embed UnimplementedKeyQuarryServer
-// for forward compatibility
-type KeyQuarryServer interface {
-	// Set sets a key/value pair. If the key already exists,
-	// it will be updated. If the key already exists and is
-	// locked, an error will be returned. If the key already
-	// exists and is expired (and not locked), it will be deleted
-	// and created as new.
-	Set(context.Context, *KeyValue) (*SetResponse, error)
-	// Get returns the value of a key. If the key does not exist,
-	// an error will be returned.
-	Get(context.Context, *Key) (*GetResponse, error)
-	// Inspect returns metadata about a key. If the key does not
-	// exist, an error will be returned.
-	Inspect(context.Context, *InspectRequest) (*InspectResponse, error)
-	// Delete deletes a key. If the key does not exist, an error
-	// will be returned. If the key is locked, an error will
-	// be returned.
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
-	// Exists indicates whether a key exists.
-	Exists(context.Context, *Key) (*ExistsResponse, error)
-	// Pop returns the value of a key and deletes it. If the key
-	// is locked or doesn't exist, an error will be returned.
-	Pop(context.Context, *PopRequest) (*GetResponse, error)
-	// Clear deletes all unlocked keys from the store.
-	Clear(context.Context, *ClearRequest) (*ClearResponse, error)
-	// ListKeys returns a list of keys matching a pattern.
-	ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error)
-	// Stats returns statistics about the store.
-	Stats(context.Context, *EmptyRequest) (*ServerMetrics, error)
-	// ClearHistory deletes all history entries for unlocked keys.
-	ClearHistory(context.Context, *EmptyRequest) (*ClearHistoryResponse, error)
-	// Lock locks a key for a given duration. If the key is already locked, an
-	// error will be returned. If no duration is provided, the lock will not
-	// expire.
-	Lock(context.Context, *LockRequest) (*LockResponse, error)
-	// Unlock unlocks a key. If the key is not locked, nothing will happen.
-	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
-	// GetRevision gets the value of a key at a specific revision, with
-	// its timestamp
-	GetRevision(context.Context, *GetRevisionRequest) (*RevisionResponse, error)
-	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
-	SetReadOnly(context.Context, *ReadOnlyRequest) (*ReadOnlyResponse, error)
-	WatchStream(*WatchRequest, KeyQuarry_WatchStreamServer) error
-	GetKeyMetric(context.Context, *KeyMetricRequest) (*KeyMetric, error)
-	WatchKeyValue(*WatchKeyValueRequest, KeyQuarry_WatchKeyValueServer) error
-	mustEmbedUnimplementedKeyQuarryServer()
+func (c *keyQuarryClient) Txn(ctx context.Context, in *TxnRequest, opts ...grpc.CallOption) (*TxnResponse, error) {
+	out := new(TxnResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/Txn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedKeyQuarryServer must be embedded to have forward compatible implementations.
-type UnimplementedKeyQuarryServer struct {
+func (c *keyQuarryClient) BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error) {
+	out := new(BatchGetResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/BatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedKeyQuarryServer) Set(context.Context, *KeyValue) (*SetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+func (c *keyQuarryClient) BatchSet(ctx context.Context, in *BatchSetRequest, opts ...grpc.CallOption) (*BatchSetResponse, error) {
+	out := new(BatchSetResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/BatchSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKeyQuarryServer) Get(context.Context, *Key) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+
+func (c *keyQuarryClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteResponse, error) {
+	out := new(BatchDeleteResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKeyQuarryServer) Inspect(context.Context, *InspectRequest) (*InspectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Inspect not implemented")
+
+func (c *keyQuarryClient) Increment(ctx context.Context, in *IncrementRequest, opts ...grpc.CallOption) (*IncrementResponse, error) {
+	out := new(IncrementResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/Increment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKeyQuarryServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+
+func (c *keyQuarryClient) Scan(ctx context.Context, in *ScanRequest, opts ...grpc.CallOption) (KeyQuarry_ScanClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KeyQuarry_ServiceDesc.Streams[3], "/keyquarry.KeyQuarry/Scan", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keyQuarryScanClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedKeyQuarryServer) Exists(context.Context, *Key) (*ExistsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Exists not implemented")
+
+type KeyQuarry_ScanClient interface {
+	Recv() (*ScanResult, error)
+	grpc.ClientStream
 }
-func (UnimplementedKeyQuarryServer) Pop(context.Context, *PopRequest) (*GetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pop not implemented")
+
+type keyQuarryScanClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedKeyQuarryServer) Clear(context.Context, *ClearRequest) (*ClearResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
+
+func (x *keyQuarryScanClient) Recv() (*ScanResult, error) {
+	m := new(ScanResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedKeyQuarryServer) ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+
+func (c *keyQuarryClient) Rename(ctx context.Context, in *RenameRequest, opts ...grpc.CallOption) (*RenameResponse, error) {
+	out := new(RenameResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/Rename", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKeyQuarryServer) Stats(context.Context, *EmptyRequest) (*ServerMetrics, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+
+func (c *keyQuarryClient) Copy(ctx context.Context, in *CopyRequest, opts ...grpc.CallOption) (*CopyResponse, error) {
+	out := new(CopyResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/Copy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKeyQuarryServer) ClearHistory(context.Context, *EmptyRequest) (*ClearHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearHistory not implemented")
+
+func (c *keyQuarryClient) Persist(ctx context.Context, in *PersistRequest, opts ...grpc.CallOption) (*PersistResponse, error) {
+	out := new(PersistResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/Persist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKeyQuarryServer) Lock(context.Context, *LockRequest) (*LockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+
+func (c *keyQuarryClient) Campaign(ctx context.Context, in *CampaignRequest, opts ...grpc.CallOption) (*CampaignResponse, error) {
+	out := new(CampaignResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/Campaign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKeyQuarryServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+
+func (c *keyQuarryClient) Resign(ctx context.Context, in *ResignRequest, opts ...grpc.CallOption) (*ResignResponse, error) {
+	out := new(ResignResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/Resign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKeyQuarryServer) GetRevision(context.Context, *GetRevisionRequest) (*RevisionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+
+func (c *keyQuarryClient) Leader(ctx context.Context, in *LeaderRequest, opts ...grpc.CallOption) (*LeaderResponse, error) {
+	out := new(LeaderResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/Leader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedKeyQuarryServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+
+func (c *keyQuarryClient) ObserveLeader(ctx context.Context, in *LeaderRequest, opts ...grpc.CallOption) (KeyQuarry_ObserveLeaderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KeyQuarry_ServiceDesc.Streams[4], "/keyquarry.KeyQuarry/ObserveLeader", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keyQuarryObserveLeaderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedKeyQuarryServer) SetReadOnly(context.Context, *ReadOnlyRequest) (*ReadOnlyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetReadOnly not implemented")
+
+type KeyQuarry_ObserveLeaderClient interface {
+	Recv() (*LeaderResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedKeyQuarryServer) WatchStream(*WatchRequest, KeyQuarry_WatchStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchStream not implemented")
+
+type keyQuarryObserveLeaderClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedKeyQuarryServer) GetKeyMetric(context.Context, *KeyMetricRequest) (*KeyMetric, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetKeyMetric not implemented")
+
+func (x *keyQuarryObserveLeaderClient) Recv() (*LeaderResponse, error) {
+	m := new(LeaderResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedKeyQuarryServer) WatchKeyValue(*WatchKeyValueRequest, KeyQuarry_WatchKeyValueServer) error {
-	return status.Errorf(codes.Unimplemented, "method WatchKeyValue not implemented")
+
+func (c *keyQuarryClient) AcquireSemaphore(ctx context.Context, in *AcquireSemaphoreRequest, opts ...grpc.CallOption) (*AcquireSemaphoreResponse, error) {
+	out := new(AcquireSemaphoreResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/AcquireSemaphore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) ReleaseSemaphore(ctx context.Context, in *ReleaseSemaphoreRequest, opts ...grpc.CallOption) (*ReleaseSemaphoreResponse, error) {
+	out := new(ReleaseSemaphoreResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/ReleaseSemaphore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) ListPush(ctx context.Context, in *ListPushRequest, opts ...grpc.CallOption) (*ListPushResponse, error) {
+	out := new(ListPushResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/ListPush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) ListPop(ctx context.Context, in *ListPopRequest, opts ...grpc.CallOption) (*ListPopResponse, error) {
+	out := new(ListPopResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/ListPop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) ListRange(ctx context.Context, in *ListRangeRequest, opts ...grpc.CallOption) (*ListRangeResponse, error) {
+	out := new(ListRangeResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/ListRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) ListLen(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ListLenResponse, error) {
+	out := new(ListLenResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/ListLen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) HSet(ctx context.Context, in *HSetRequest, opts ...grpc.CallOption) (*HSetResponse, error) {
+	out := new(HSetResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/HSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) HGet(ctx context.Context, in *HGetRequest, opts ...grpc.CallOption) (*HGetResponse, error) {
+	out := new(HGetResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/HGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) HDel(ctx context.Context, in *HDelRequest, opts ...grpc.CallOption) (*HDelResponse, error) {
+	out := new(HDelResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/HDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) HGetAll(ctx context.Context, in *Key, opts ...grpc.CallOption) (*HGetAllResponse, error) {
+	out := new(HGetAllResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/HGetAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) HIncrBy(ctx context.Context, in *HIncrByRequest, opts ...grpc.CallOption) (*HIncrByResponse, error) {
+	out := new(HIncrByResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/HIncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) SAdd(ctx context.Context, in *SetMembersRequest, opts ...grpc.CallOption) (*SAddResponse, error) {
+	out := new(SAddResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/SAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) SRem(ctx context.Context, in *SetMembersRequest, opts ...grpc.CallOption) (*SRemResponse, error) {
+	out := new(SRemResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/SRem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) SMembers(ctx context.Context, in *Key, opts ...grpc.CallOption) (*SMembersResponse, error) {
+	out := new(SMembersResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/SMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) SIsMember(ctx context.Context, in *SIsMemberRequest, opts ...grpc.CallOption) (*SIsMemberResponse, error) {
+	out := new(SIsMemberResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/SIsMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) ZAdd(ctx context.Context, in *ZAddRequest, opts ...grpc.CallOption) (*ZAddResponse, error) {
+	out := new(ZAddResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/ZAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) ZRangeByScore(ctx context.Context, in *ZRangeByScoreRequest, opts ...grpc.CallOption) (*ZMembersResponse, error) {
+	out := new(ZMembersResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/ZRangeByScore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) ZRem(ctx context.Context, in *SetMembersRequest, opts ...grpc.CallOption) (*ZRemResponse, error) {
+	out := new(ZRemResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/ZRem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) ZPopMin(ctx context.Context, in *ZPopMinRequest, opts ...grpc.CallOption) (*ZMembersResponse, error) {
+	out := new(ZMembersResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/ZPopMin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) Publish(ctx context.Context, in *PublishRequest, opts ...grpc.CallOption) (*PublishResponse, error) {
+	out := new(PublishResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/Publish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) SubscribeTopic(ctx context.Context, in *SubscribeTopicRequest, opts ...grpc.CallOption) (KeyQuarry_SubscribeTopicClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KeyQuarry_ServiceDesc.Streams[5], "/keyquarry.KeyQuarry/SubscribeTopic", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keyQuarrySubscribeTopicClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KeyQuarry_SubscribeTopicClient interface {
+	Recv() (*TopicMessage, error)
+	grpc.ClientStream
+}
+
+type keyQuarrySubscribeTopicClient struct {
+	grpc.ClientStream
+}
+
+func (x *keyQuarrySubscribeTopicClient) Recv() (*TopicMessage, error) {
+	m := new(TopicMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *keyQuarryClient) JSONGet(ctx context.Context, in *JSONGetRequest, opts ...grpc.CallOption) (*JSONGetResponse, error) {
+	out := new(JSONGetResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/JSONGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) JSONPatch(ctx context.Context, in *JSONPatchRequest, opts ...grpc.CallOption) (*JSONPatchResponse, error) {
+	out := new(JSONPatchResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/JSONPatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) ListRevisions(ctx context.Context, in *Key, opts ...grpc.CallOption) (*ListRevisionsResponse, error) {
+	out := new(ListRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/ListRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *keyQuarryClient) Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error) {
+	out := new(RollbackResponse)
+	err := c.cc.Invoke(ctx, "/keyquarry.KeyQuarry/Rollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KeyQuarryServer is the server API for KeyQuarry service.
+// All implementations must embed UnimplementedKeyQuarryServer
+// for forward compatibility
+type KeyQuarryServer interface {
+	// Set sets a key/value pair. If the key already exists,
+	// it will be updated. If the key already exists and is
+	// locked, an error will be returned. If the key already
+	// exists and is expired (and not locked), it will be deleted
+	// and created as new. If a WriteMode is provided and its
+	// condition isn't met, a FailedPrecondition error will be returned.
+	Set(context.Context, *KeyValue) (*SetResponse, error)
+	// Get returns the value of a key. If the key does not exist,
+	// an error will be returned.
+	Get(context.Context, *Key) (*GetResponse, error)
+	// Inspect returns metadata about a key. If the key does not
+	// exist, an error will be returned.
+	Inspect(context.Context, *InspectRequest) (*InspectResponse, error)
+	// Delete deletes a key. If the key does not exist, an error
+	// will be returned. If the key is locked, an error will
+	// be returned. If a WriteMode is provided and its condition
+	// isn't met, a FailedPrecondition error will be returned.
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// Exists indicates whether a key exists.
+	Exists(context.Context, *Key) (*ExistsResponse, error)
+	// Pop returns the value of a key and deletes it. If the key
+	// is locked or doesn't exist, an error will be returned.
+	Pop(context.Context, *PopRequest) (*GetResponse, error)
+	// Clear deletes all unlocked keys from the store.
+	Clear(context.Context, *ClearRequest) (*ClearResponse, error)
+	// ListKeys returns a sorted list of keys matching a pattern and/or
+	// prefix. If a limit is provided and there are more matching keys,
+	// next_page_token can be used to retrieve the next page.
+	ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error)
+	// Stats returns statistics about the store.
+	Stats(context.Context, *EmptyRequest) (*ServerMetrics, error)
+	// ClearHistory deletes all history entries for unlocked keys.
+	ClearHistory(context.Context, *EmptyRequest) (*ClearHistoryResponse, error)
+	// Lock locks a key for a given duration. If the key is already locked, an
+	// error will be returned. If no duration is provided, the lock will not
+	// expire.
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
+	// Unlock unlocks a key. If the key is not locked, nothing will happen.
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	// GetRevision gets the value of a key at a specific revision, with
+	// its timestamp
+	GetRevision(context.Context, *GetRevisionRequest) (*RevisionResponse, error)
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// KeepAlive renews the given lease each time a request is received,
+	// responding with its new expiration time
+	KeepAlive(KeyQuarry_KeepAliveServer) error
+	SetReadOnly(context.Context, *ReadOnlyRequest) (*ReadOnlyResponse, error)
+	WatchStream(*WatchRequest, KeyQuarry_WatchStreamServer) error
+	GetKeyMetric(context.Context, *KeyMetricRequest) (*KeyMetric, error)
+	WatchKeyValue(*WatchKeyValueRequest, KeyQuarry_WatchKeyValueServer) error
+	// Txn applies a list of operations atomically, if all of its guards
+	// are met. Either every operation is applied, or none are. If a guard
+	// isn't met, a FailedPrecondition error will be returned.
+	Txn(context.Context, *TxnRequest) (*TxnResponse, error)
+	// BatchGet gets the values of multiple keys, with a result (or error)
+	// for each key.
+	BatchGet(context.Context, *BatchGetRequest) (*BatchGetResponse, error)
+	// BatchSet sets multiple keys, with a result (or error) for each key.
+	// Unlike Txn, items are applied independently, so an error for one
+	// item doesn't prevent the others from being set.
+	BatchSet(context.Context, *BatchSetRequest) (*BatchSetResponse, error)
+	// BatchDelete deletes multiple keys, with a result (or error) for
+	// each key.
+	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteResponse, error)
+	// Increment atomically adds an amount to the value of a key, treating
+	// it as a signed 64-bit integer, and returns the new value. If the key
+	// doesn't exist, it will be created. A negative amount decrements
+	// the value.
+	Increment(context.Context, *IncrementRequest) (*IncrementResponse, error)
+	// Scan streams the keys in a range and/or with a prefix, sorted
+	// lexicographically, along with their values and metadata. Results
+	// reflect the state of the store at the time of the request. Keys
+	// locked by another client are skipped, unless include_locked is set.
+	Scan(*ScanRequest, KeyQuarry_ScanServer) error
+	// Rename atomically moves a key's value, content type, lifespan and
+	// (optionally) revision history to a new key. If the destination
+	// exists, an error will be returned unless overwrite is set. If either
+	// key is locked by another client, an error will be returned.
+	Rename(context.Context, *RenameRequest) (*RenameResponse, error)
+	// Copy atomically duplicates a key's value, content type, lifespan and
+	// (optionally) revision history to a new key, with the same conditions
+	// as Rename.
+	Copy(context.Context, *CopyRequest) (*CopyResponse, error)
+	// Persist removes a key's lifespan, so it won't expire, without
+	// changing its value. If the key is locked by another client, an
+	// error will be returned.
+	Persist(context.Context, *PersistRequest) (*PersistResponse, error)
+	// Campaign waits until the client becomes leader of the named election,
+	// then publishes the given value as the leader's value. Leadership is
+	// held with a lock on the election, which lapses after the lease
+	// duration unless the leader renews it by campaigning again.
+	Campaign(context.Context, *CampaignRequest) (*CampaignResponse, error)
+	// Resign gives up leadership of the named election, if held by the client
+	Resign(context.Context, *ResignRequest) (*ResignResponse, error)
+	// Leader returns the current leader of the named election
+	Leader(context.Context, *LeaderRequest) (*LeaderResponse, error)
+	// ObserveLeader streams the leader of the named election each time
+	// it changes, starting with the current leader
+	ObserveLeader(*LeaderRequest, KeyQuarry_ObserveLeaderServer) error
+	// AcquireSemaphore acquires (or renews) one of the permits of the
+	// semaphore on a key, creating the key if it doesn't exist. Each permit
+	// is released after its TTL, unless renewed.
+	AcquireSemaphore(context.Context, *AcquireSemaphoreRequest) (*AcquireSemaphoreResponse, error)
+	// ReleaseSemaphore releases the client's permit for a semaphore
+	ReleaseSemaphore(context.Context, *ReleaseSemaphoreRequest) (*ReleaseSemaphoreResponse, error)
+	// ListPush adds values to the start or end of a list, creating the
+	// key if it doesn't exist
+	ListPush(context.Context, *ListPushRequest) (*ListPushResponse, error)
+	// ListPop removes and returns the value at the start or end of a list,
+	// optionally waiting for a value to be pushed if the list is empty
+	ListPop(context.Context, *ListPopRequest) (*ListPopResponse, error)
+	// ListRange returns the values of a list between two indexes
+	ListRange(context.Context, *ListRangeRequest) (*ListRangeResponse, error)
+	// ListLen returns the number of values in a list
+	ListLen(context.Context, *Key) (*ListLenResponse, error)
+	// HSet sets fields of a hash, creating the key if it doesn't exist
+	HSet(context.Context, *HSetRequest) (*HSetResponse, error)
+	// HGet returns the value of a field of a hash
+	HGet(context.Context, *HGetRequest) (*HGetResponse, error)
+	// HDel deletes fields from a hash
+	HDel(context.Context, *HDelRequest) (*HDelResponse, error)
+	// HGetAll returns all the fields of a hash
+	HGetAll(context.Context, *Key) (*HGetAllResponse, error)
+	// HIncrBy adds to the integer value of a field of a hash, creating
+	// the key and field if they don't exist
+	HIncrBy(context.Context, *HIncrByRequest) (*HIncrByResponse, error)
+	// SAdd adds members to a set, creating the key if it doesn't exist
+	SAdd(context.Context, *SetMembersRequest) (*SAddResponse, error)
+	// SRem removes members from a set
+	SRem(context.Context, *SetMembersRequest) (*SRemResponse, error)
+	// SMembers returns the members of a set
+	SMembers(context.Context, *Key) (*SMembersResponse, error)
+	// SIsMember returns whether a value is a member of a set
+	SIsMember(context.Context, *SIsMemberRequest) (*SIsMemberResponse, error)
+	// ZAdd adds members to a sorted set (or updates their scores),
+	// creating the key if it doesn't exist
+	ZAdd(context.Context, *ZAddRequest) (*ZAddResponse, error)
+	// ZRangeByScore returns the members of a sorted set with scores
+	// between a minimum and maximum, ordered by score
+	ZRangeByScore(context.Context, *ZRangeByScoreRequest) (*ZMembersResponse, error)
+	// ZRem removes members from a sorted set
+	ZRem(context.Context, *SetMembersRequest) (*ZRemResponse, error)
+	// ZPopMin removes and returns the members of a sorted set with the
+	// lowest scores
+	ZPopMin(context.Context, *ZPopMinRequest) (*ZMembersResponse, error)
+	// Publish sends a message to the subscribers of a topic. Messages
+	// aren't stored, so are only received by current subscribers.
+	Publish(context.Context, *PublishRequest) (*PublishResponse, error)
+	// SubscribeTopic streams messages published to topics matching any
+	// of the given glob patterns
+	SubscribeTopic(*SubscribeTopicRequest, KeyQuarry_SubscribeTopicServer) error
+	// JSONGet returns the part of a JSON value selected by a JSONPath
+	// expression
+	JSONGet(context.Context, *JSONGetRequest) (*JSONGetResponse, error)
+	// JSONPatch atomically applies a JSON Patch (RFC 6902) to a JSON value
+	JSONPatch(context.Context, *JSONPatchRequest) (*JSONPatchResponse, error)
+	// ListRevisions returns the metadata of each of the revisions of a key
+	// held in its history, oldest first
+	ListRevisions(context.Context, *Key) (*ListRevisionsResponse, error)
+	// Rollback sets the value of a key to the value of one of its
+	// revisions, as a new version. The same conditions as Set apply.
+	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
+	mustEmbedUnimplementedKeyQuarryServer()
+}
+
+// UnimplementedKeyQuarryServer must be embedded to have forward compatible implementations.
+type UnimplementedKeyQuarryServer struct {
+}
+
+func (UnimplementedKeyQuarryServer) Set(context.Context, *KeyValue) (*SetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedKeyQuarryServer) Get(context.Context, *Key) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedKeyQuarryServer) Inspect(context.Context, *InspectRequest) (*InspectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inspect not implemented")
+}
+func (UnimplementedKeyQuarryServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedKeyQuarryServer) Exists(context.Context, *Key) (*ExistsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Exists not implemented")
+}
+func (UnimplementedKeyQuarryServer) Pop(context.Context, *PopRequest) (*GetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pop not implemented")
+}
+func (UnimplementedKeyQuarryServer) Clear(context.Context, *ClearRequest) (*ClearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clear not implemented")
+}
+func (UnimplementedKeyQuarryServer) ListKeys(context.Context, *ListKeysRequest) (*ListKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
+}
+func (UnimplementedKeyQuarryServer) Stats(context.Context, *EmptyRequest) (*ServerMetrics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedKeyQuarryServer) ClearHistory(context.Context, *EmptyRequest) (*ClearHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearHistory not implemented")
+}
+func (UnimplementedKeyQuarryServer) Lock(context.Context, *LockRequest) (*LockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (UnimplementedKeyQuarryServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedKeyQuarryServer) GetRevision(context.Context, *GetRevisionRequest) (*RevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRevision not implemented")
+}
+func (UnimplementedKeyQuarryServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedKeyQuarryServer) KeepAlive(KeyQuarry_KeepAliveServer) error {
+	return status.Errorf(codes.Unimplemented, "method KeepAlive not implemented")
+}
+func (UnimplementedKeyQuarryServer) SetReadOnly(context.Context, *ReadOnlyRequest) (*ReadOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReadOnly not implemented")
+}
+func (UnimplementedKeyQuarryServer) WatchStream(*WatchRequest, KeyQuarry_WatchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStream not implemented")
+}
+func (UnimplementedKeyQuarryServer) GetKeyMetric(context.Context, *KeyMetricRequest) (*KeyMetric, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyMetric not implemented")
+}
+func (UnimplementedKeyQuarryServer) WatchKeyValue(*WatchKeyValueRequest, KeyQuarry_WatchKeyValueServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchKeyValue not implemented")
+}
+func (UnimplementedKeyQuarryServer) Txn(context.Context, *TxnRequest) (*TxnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Txn not implemented")
+}
+func (UnimplementedKeyQuarryServer) BatchGet(context.Context, *BatchGetRequest) (*BatchGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGet not implemented")
+}
+func (UnimplementedKeyQuarryServer) BatchSet(context.Context, *BatchSetRequest) (*BatchSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchSet not implemented")
+}
+func (UnimplementedKeyQuarryServer) BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
+}
+func (UnimplementedKeyQuarryServer) Increment(context.Context, *IncrementRequest) (*IncrementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Increment not implemented")
+}
+func (UnimplementedKeyQuarryServer) Scan(*ScanRequest, KeyQuarry_ScanServer) error {
+	return status.Errorf(codes.Unimplemented, "method Scan not implemented")
+}
+func (UnimplementedKeyQuarryServer) Rename(context.Context, *RenameRequest) (*RenameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rename not implemented")
+}
+func (UnimplementedKeyQuarryServer) Copy(context.Context, *CopyRequest) (*CopyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Copy not implemented")
+}
+func (UnimplementedKeyQuarryServer) Persist(context.Context, *PersistRequest) (*PersistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Persist not implemented")
+}
+func (UnimplementedKeyQuarryServer) Campaign(context.Context, *CampaignRequest) (*CampaignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Campaign not implemented")
+}
+func (UnimplementedKeyQuarryServer) Resign(context.Context, *ResignRequest) (*ResignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resign not implemented")
+}
+func (UnimplementedKeyQuarryServer) Leader(context.Context, *LeaderRequest) (*LeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Leader not implemented")
+}
+func (UnimplementedKeyQuarryServer) ObserveLeader(*LeaderRequest, KeyQuarry_ObserveLeaderServer) error {
+	return status.Errorf(codes.Unimplemented, "method ObserveLeader not implemented")
+}
+func (UnimplementedKeyQuarryServer) AcquireSemaphore(context.Context, *AcquireSemaphoreRequest) (*AcquireSemaphoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireSemaphore not implemented")
+}
+func (UnimplementedKeyQuarryServer) ReleaseSemaphore(context.Context, *ReleaseSemaphoreRequest) (*ReleaseSemaphoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSemaphore not implemented")
+}
+func (UnimplementedKeyQuarryServer) ListPush(context.Context, *ListPushRequest) (*ListPushResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPush not implemented")
+}
+func (UnimplementedKeyQuarryServer) ListPop(context.Context, *ListPopRequest) (*ListPopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPop not implemented")
+}
+func (UnimplementedKeyQuarryServer) ListRange(context.Context, *ListRangeRequest) (*ListRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRange not implemented")
+}
+func (UnimplementedKeyQuarryServer) ListLen(context.Context, *Key) (*ListLenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLen not implemented")
+}
+func (UnimplementedKeyQuarryServer) HSet(context.Context, *HSetRequest) (*HSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HSet not implemented")
+}
+func (UnimplementedKeyQuarryServer) HGet(context.Context, *HGetRequest) (*HGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGet not implemented")
+}
+func (UnimplementedKeyQuarryServer) HDel(context.Context, *HDelRequest) (*HDelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HDel not implemented")
+}
+func (UnimplementedKeyQuarryServer) HGetAll(context.Context, *Key) (*HGetAllResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HGetAll not implemented")
+}
+func (UnimplementedKeyQuarryServer) HIncrBy(context.Context, *HIncrByRequest) (*HIncrByResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HIncrBy not implemented")
+}
+func (UnimplementedKeyQuarryServer) SAdd(context.Context, *SetMembersRequest) (*SAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SAdd not implemented")
+}
+func (UnimplementedKeyQuarryServer) SRem(context.Context, *SetMembersRequest) (*SRemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRem not implemented")
+}
+func (UnimplementedKeyQuarryServer) SMembers(context.Context, *Key) (*SMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SMembers not implemented")
+}
+func (UnimplementedKeyQuarryServer) SIsMember(context.Context, *SIsMemberRequest) (*SIsMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SIsMember not implemented")
+}
+func (UnimplementedKeyQuarryServer) ZAdd(context.Context, *ZAddRequest) (*ZAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZAdd not implemented")
+}
+func (UnimplementedKeyQuarryServer) ZRangeByScore(context.Context, *ZRangeByScoreRequest) (*ZMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRangeByScore not implemented")
+}
+func (UnimplementedKeyQuarryServer) ZRem(context.Context, *SetMembersRequest) (*ZRemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZRem not implemented")
+}
+func (UnimplementedKeyQuarryServer) ZPopMin(context.Context, *ZPopMinRequest) (*ZMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZPopMin not implemented")
+}
+func (UnimplementedKeyQuarryServer) Publish(context.Context, *PublishRequest) (*PublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedKeyQuarryServer) SubscribeTopic(*SubscribeTopicRequest, KeyQuarry_SubscribeTopicServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTopic not implemented")
+}
+func (UnimplementedKeyQuarryServer) JSONGet(context.Context, *JSONGetRequest) (*JSONGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONGet not implemented")
+}
+func (UnimplementedKeyQuarryServer) JSONPatch(context.Context, *JSONPatchRequest) (*JSONPatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONPatch not implemented")
+}
+func (UnimplementedKeyQuarryServer) ListRevisions(context.Context, *Key) (*ListRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRevisions not implemented")
+}
+func (UnimplementedKeyQuarryServer) Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (UnimplementedKeyQuarryServer) mustEmbedUnimplementedKeyQuarryServer() {}
+
+// UnsafeKeyQuarryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KeyQuarryServer will
+// result in compilation errors.
+type UnsafeKeyQuarryServer interface {
+	mustEmbedUnimplementedKeyQuarryServer()
+}
+
+func RegisterKeyQuarryServer(s grpc.ServiceRegistrar, srv KeyQuarryServer) {
+	s.RegisterService(&KeyQuarry_ServiceDesc, srv)
+}
+
+func _KeyQuarry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Set(ctx, req.(*KeyValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Get(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Inspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Inspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Inspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Inspect(ctx, req.(*InspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Exists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Exists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Exists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Exists(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Pop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Pop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Pop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Pop(ctx, req.(*PopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Clear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Clear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Clear(ctx, req.(*ClearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).ListKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/ListKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).ListKeys(ctx, req.(*ListKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Stats(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_ClearHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).ClearHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/ClearHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).ClearHistory(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).GetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/GetRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).GetRevision(ctx, req.(*GetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_KeepAlive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(KeyQuarryServer).KeepAlive(&keyQuarryKeepAliveServer{stream})
+}
+
+type KeyQuarry_KeepAliveServer interface {
+	Send(*KeepAliveResponse) error
+	Recv() (*KeepAliveRequest, error)
+	grpc.ServerStream
+}
+
+type keyQuarryKeepAliveServer struct {
+	grpc.ServerStream
+}
+
+func (x *keyQuarryKeepAliveServer) Send(m *KeepAliveResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *keyQuarryKeepAliveServer) Recv() (*KeepAliveRequest, error) {
+	m := new(KeepAliveRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _KeyQuarry_SetReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).SetReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/SetReadOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).SetReadOnly(ctx, req.(*ReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_WatchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeyQuarryServer).WatchStream(m, &keyQuarryWatchStreamServer{stream})
+}
+
+type KeyQuarry_WatchStreamServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type keyQuarryWatchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *keyQuarryWatchStreamServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KeyQuarry_GetKeyMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyMetricRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).GetKeyMetric(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/GetKeyMetric",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).GetKeyMetric(ctx, req.(*KeyMetricRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_WatchKeyValue_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchKeyValueRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeyQuarryServer).WatchKeyValue(m, &keyQuarryWatchKeyValueServer{stream})
+}
+
+type KeyQuarry_WatchKeyValueServer interface {
+	Send(*WatchKeyValueResponse) error
+	grpc.ServerStream
+}
+
+type keyQuarryWatchKeyValueServer struct {
+	grpc.ServerStream
+}
+
+func (x *keyQuarryWatchKeyValueServer) Send(m *WatchKeyValueResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KeyQuarry_Txn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Txn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Txn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Txn(ctx, req.(*TxnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/BatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).BatchGet(ctx, req.(*BatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_BatchSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).BatchSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/BatchSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).BatchSet(ctx, req.(*BatchSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).BatchDelete(ctx, req.(*BatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Increment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Increment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Increment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Increment(ctx, req.(*IncrementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Scan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ScanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeyQuarryServer).Scan(m, &keyQuarryScanServer{stream})
+}
+
+type KeyQuarry_ScanServer interface {
+	Send(*ScanResult) error
+	grpc.ServerStream
+}
+
+type keyQuarryScanServer struct {
+	grpc.ServerStream
+}
+
+func (x *keyQuarryScanServer) Send(m *ScanResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KeyQuarry_Rename_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Rename(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Rename",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Rename(ctx, req.(*RenameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Copy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Copy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Copy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Copy(ctx, req.(*CopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Persist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PersistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Persist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Persist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Persist(ctx, req.(*PersistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Campaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Campaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Campaign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Campaign(ctx, req.(*CampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Resign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Resign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Resign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Resign(ctx, req.(*ResignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_Leader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Leader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Leader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Leader(ctx, req.(*LeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_ObserveLeader_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LeaderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeyQuarryServer).ObserveLeader(m, &keyQuarryObserveLeaderServer{stream})
+}
+
+type KeyQuarry_ObserveLeaderServer interface {
+	Send(*LeaderResponse) error
+	grpc.ServerStream
+}
+
+type keyQuarryObserveLeaderServer struct {
+	grpc.ServerStream
+}
+
+func (x *keyQuarryObserveLeaderServer) Send(m *LeaderResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _KeyQuarry_AcquireSemaphore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcquireSemaphoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).AcquireSemaphore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/AcquireSemaphore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).AcquireSemaphore(ctx, req.(*AcquireSemaphoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_ReleaseSemaphore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseSemaphoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).ReleaseSemaphore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/ReleaseSemaphore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).ReleaseSemaphore(ctx, req.(*ReleaseSemaphoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KeyQuarry_ListPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPushRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).ListPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/ListPush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).ListPush(ctx, req.(*ListPushRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedKeyQuarryServer) mustEmbedUnimplementedKeyQuarryServer() {}
 
-// UnsafeKeyQuarryServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to KeyQuarryServer will
-// result in compilation errors.
-type UnsafeKeyQuarryServer interface {
-	mustEmbedUnimplementedKeyQuarryServer()
+func _KeyQuarry_ListPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).ListPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/ListPop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).ListPop(ctx, req.(*ListPopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterKeyQuarryServer(s grpc.ServiceRegistrar, srv KeyQuarryServer) {
-	s.RegisterService(&KeyQuarry_ServiceDesc, srv)
+func _KeyQuarry_ListRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).ListRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/ListRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).ListRange(ctx, req.(*ListRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KeyValue)
+func _KeyQuarry_ListLen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).Set(ctx, in)
+		return srv.(KeyQuarryServer).ListLen(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/Set",
+		FullMethod: "/keyquarry.KeyQuarry/ListLen",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).Set(ctx, req.(*KeyValue))
+		return srv.(KeyQuarryServer).ListLen(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _KeyQuarry_HSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HSetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).Get(ctx, in)
+		return srv.(KeyQuarryServer).HSet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/Get",
+		FullMethod: "/keyquarry.KeyQuarry/HSet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).Get(ctx, req.(*Key))
+		return srv.(KeyQuarryServer).HSet(ctx, req.(*HSetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_Inspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InspectRequest)
+func _KeyQuarry_HGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HGetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).Inspect(ctx, in)
+		return srv.(KeyQuarryServer).HGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/Inspect",
+		FullMethod: "/keyquarry.KeyQuarry/HGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).Inspect(ctx, req.(*InspectRequest))
+		return srv.(KeyQuarryServer).HGet(ctx, req.(*HGetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
+func _KeyQuarry_HDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HDelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).Delete(ctx, in)
+		return srv.(KeyQuarryServer).HDel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/Delete",
+		FullMethod: "/keyquarry.KeyQuarry/HDel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).Delete(ctx, req.(*DeleteRequest))
+		return srv.(KeyQuarryServer).HDel(ctx, req.(*HDelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_Exists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _KeyQuarry_HGetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).Exists(ctx, in)
+		return srv.(KeyQuarryServer).HGetAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/Exists",
+		FullMethod: "/keyquarry.KeyQuarry/HGetAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).Exists(ctx, req.(*Key))
+		return srv.(KeyQuarryServer).HGetAll(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_Pop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PopRequest)
+func _KeyQuarry_HIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HIncrByRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).Pop(ctx, in)
+		return srv.(KeyQuarryServer).HIncrBy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/Pop",
+		FullMethod: "/keyquarry.KeyQuarry/HIncrBy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).Pop(ctx, req.(*PopRequest))
+		return srv.(KeyQuarryServer).HIncrBy(ctx, req.(*HIncrByRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_Clear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearRequest)
+func _KeyQuarry_SAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).Clear(ctx, in)
+		return srv.(KeyQuarryServer).SAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/Clear",
+		FullMethod: "/keyquarry.KeyQuarry/SAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).Clear(ctx, req.(*ClearRequest))
+		return srv.(KeyQuarryServer).SAdd(ctx, req.(*SetMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListKeysRequest)
+func _KeyQuarry_SRem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).ListKeys(ctx, in)
+		return srv.(KeyQuarryServer).SRem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/ListKeys",
+		FullMethod: "/keyquarry.KeyQuarry/SRem",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).ListKeys(ctx, req.(*ListKeysRequest))
+		return srv.(KeyQuarryServer).SRem(ctx, req.(*SetMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _KeyQuarry_SMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).Stats(ctx, in)
+		return srv.(KeyQuarryServer).SMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/Stats",
+		FullMethod: "/keyquarry.KeyQuarry/SMembers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).Stats(ctx, req.(*EmptyRequest))
+		return srv.(KeyQuarryServer).SMembers(ctx, req.(*Key))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_ClearHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _KeyQuarry_SIsMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SIsMemberRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).ClearHistory(ctx, in)
+		return srv.(KeyQuarryServer).SIsMember(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/ClearHistory",
+		FullMethod: "/keyquarry.KeyQuarry/SIsMember",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).ClearHistory(ctx, req.(*EmptyRequest))
+		return srv.(KeyQuarryServer).SIsMember(ctx, req.(*SIsMemberRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LockRequest)
+func _KeyQuarry_ZAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZAddRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).Lock(ctx, in)
+		return srv.(KeyQuarryServer).ZAdd(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/Lock",
+		FullMethod: "/keyquarry.KeyQuarry/ZAdd",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).Lock(ctx, req.(*LockRequest))
+		return srv.(KeyQuarryServer).ZAdd(ctx, req.(*ZAddRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnlockRequest)
+func _KeyQuarry_ZRangeByScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZRangeByScoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).Unlock(ctx, in)
+		return srv.(KeyQuarryServer).ZRangeByScore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/Unlock",
+		FullMethod: "/keyquarry.KeyQuarry/ZRangeByScore",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).Unlock(ctx, req.(*UnlockRequest))
+		return srv.(KeyQuarryServer).ZRangeByScore(ctx, req.(*ZRangeByScoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_GetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRevisionRequest)
+func _KeyQuarry_ZRem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).GetRevision(ctx, in)
+		return srv.(KeyQuarryServer).ZRem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/GetRevision",
+		FullMethod: "/keyquarry.KeyQuarry/ZRem",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).GetRevision(ctx, req.(*GetRevisionRequest))
+		return srv.(KeyQuarryServer).ZRem(ctx, req.(*SetMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _KeyQuarry_ZPopMin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ZPopMinRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).Register(ctx, in)
+		return srv.(KeyQuarryServer).ZPopMin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/Register",
+		FullMethod: "/keyquarry.KeyQuarry/ZPopMin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(KeyQuarryServer).ZPopMin(ctx, req.(*ZPopMinRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_SetReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadOnlyRequest)
+func _KeyQuarry_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).SetReadOnly(ctx, in)
+		return srv.(KeyQuarryServer).Publish(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/SetReadOnly",
+		FullMethod: "/keyquarry.KeyQuarry/Publish",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).SetReadOnly(ctx, req.(*ReadOnlyRequest))
+		return srv.(KeyQuarryServer).Publish(ctx, req.(*PublishRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_WatchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WatchRequest)
+func _KeyQuarry_SubscribeTopic_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTopicRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(KeyQuarryServer).WatchStream(m, &keyQuarryWatchStreamServer{stream})
+	return srv.(KeyQuarryServer).SubscribeTopic(m, &keyQuarrySubscribeTopicServer{stream})
 }
 
-type KeyQuarry_WatchStreamServer interface {
-	Send(*Event) error
+type KeyQuarry_SubscribeTopicServer interface {
+	Send(*TopicMessage) error
 	grpc.ServerStream
 }
 
-type keyQuarryWatchStreamServer struct {
+type keyQuarrySubscribeTopicServer struct {
 	grpc.ServerStream
 }
 
-func (x *keyQuarryWatchStreamServer) Send(m *Event) error {
+func (x *keyQuarrySubscribeTopicServer) Send(m *TopicMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _KeyQuarry_GetKeyMetric_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(KeyMetricRequest)
+func _KeyQuarry_JSONGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONGetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(KeyQuarryServer).GetKeyMetric(ctx, in)
+		return srv.(KeyQuarryServer).JSONGet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/keyquarry.KeyQuarry/GetKeyMetric",
+		FullMethod: "/keyquarry.KeyQuarry/JSONGet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(KeyQuarryServer).GetKeyMetric(ctx, req.(*KeyMetricRequest))
+		return srv.(KeyQuarryServer).JSONGet(ctx, req.(*JSONGetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _KeyQuarry_WatchKeyValue_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WatchKeyValueRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _KeyQuarry_JSONPatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONPatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(KeyQuarryServer).WatchKeyValue(m, &keyQuarryWatchKeyValueServer{stream})
-}
-
-type KeyQuarry_WatchKeyValueServer interface {
-	Send(*WatchKeyValueResponse) error
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).JSONPatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/JSONPatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).JSONPatch(ctx, req.(*JSONPatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type keyQuarryWatchKeyValueServer struct {
-	grpc.ServerStream
+func _KeyQuarry_ListRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).ListRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/ListRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).ListRevisions(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *keyQuarryWatchKeyValueServer) Send(m *WatchKeyValueResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _KeyQuarry_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyQuarryServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/keyquarry.KeyQuarry/Rollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyQuarryServer).Rollback(ctx, req.(*RollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 // KeyQuarry_ServiceDesc is the grpc.ServiceDesc for KeyQuarry service.
@@ -805,8 +2321,154 @@ var KeyQuarry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetKeyMetric",
 			Handler:    _KeyQuarry_GetKeyMetric_Handler,
 		},
+		{
+			MethodName: "Txn",
+			Handler:    _KeyQuarry_Txn_Handler,
+		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _KeyQuarry_BatchGet_Handler,
+		},
+		{
+			MethodName: "BatchSet",
+			Handler:    _KeyQuarry_BatchSet_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _KeyQuarry_BatchDelete_Handler,
+		},
+		{
+			MethodName: "Increment",
+			Handler:    _KeyQuarry_Increment_Handler,
+		},
+		{
+			MethodName: "Rename",
+			Handler:    _KeyQuarry_Rename_Handler,
+		},
+		{
+			MethodName: "Copy",
+			Handler:    _KeyQuarry_Copy_Handler,
+		},
+		{
+			MethodName: "Persist",
+			Handler:    _KeyQuarry_Persist_Handler,
+		},
+		{
+			MethodName: "Campaign",
+			Handler:    _KeyQuarry_Campaign_Handler,
+		},
+		{
+			MethodName: "Resign",
+			Handler:    _KeyQuarry_Resign_Handler,
+		},
+		{
+			MethodName: "Leader",
+			Handler:    _KeyQuarry_Leader_Handler,
+		},
+		{
+			MethodName: "AcquireSemaphore",
+			Handler:    _KeyQuarry_AcquireSemaphore_Handler,
+		},
+		{
+			MethodName: "ReleaseSemaphore",
+			Handler:    _KeyQuarry_ReleaseSemaphore_Handler,
+		},
+		{
+			MethodName: "ListPush",
+			Handler:    _KeyQuarry_ListPush_Handler,
+		},
+		{
+			MethodName: "ListPop",
+			Handler:    _KeyQuarry_ListPop_Handler,
+		},
+		{
+			MethodName: "ListRange",
+			Handler:    _KeyQuarry_ListRange_Handler,
+		},
+		{
+			MethodName: "ListLen",
+			Handler:    _KeyQuarry_ListLen_Handler,
+		},
+		{
+			MethodName: "HSet",
+			Handler:    _KeyQuarry_HSet_Handler,
+		},
+		{
+			MethodName: "HGet",
+			Handler:    _KeyQuarry_HGet_Handler,
+		},
+		{
+			MethodName: "HDel",
+			Handler:    _KeyQuarry_HDel_Handler,
+		},
+		{
+			MethodName: "HGetAll",
+			Handler:    _KeyQuarry_HGetAll_Handler,
+		},
+		{
+			MethodName: "HIncrBy",
+			Handler:    _KeyQuarry_HIncrBy_Handler,
+		},
+		{
+			MethodName: "SAdd",
+			Handler:    _KeyQuarry_SAdd_Handler,
+		},
+		{
+			MethodName: "SRem",
+			Handler:    _KeyQuarry_SRem_Handler,
+		},
+		{
+			MethodName: "SMembers",
+			Handler:    _KeyQuarry_SMembers_Handler,
+		},
+		{
+			MethodName: "SIsMember",
+			Handler:    _KeyQuarry_SIsMember_Handler,
+		},
+		{
+			MethodName: "ZAdd",
+			Handler:    _KeyQuarry_ZAdd_Handler,
+		},
+		{
+			MethodName: "ZRangeByScore",
+			Handler:    _KeyQuarry_ZRangeByScore_Handler,
+		},
+		{
+			MethodName: "ZRem",
+			Handler:    _KeyQuarry_ZRem_Handler,
+		},
+		{
+			MethodName: "ZPopMin",
+			Handler:    _KeyQuarry_ZPopMin_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _KeyQuarry_Publish_Handler,
+		},
+		{
+			MethodName: "JSONGet",
+			Handler:    _KeyQuarry_JSONGet_Handler,
+		},
+		{
+			MethodName: "JSONPatch",
+			Handler:    _KeyQuarry_JSONPatch_Handler,
+		},
+		{
+			MethodName: "ListRevisions",
+			Handler:    _KeyQuarry_ListRevisions_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _KeyQuarry_Rollback_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "KeepAlive",
+			Handler:       _KeyQuarry_KeepAlive_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "WatchStream",
 			Handler:       _KeyQuarry_WatchStream_Handler,
@@ -817,6 +2479,21 @@ var KeyQuarry_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _KeyQuarry_WatchKeyValue_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Scan",
+			Handler:       _KeyQuarry_Scan_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ObserveLeader",
+			Handler:       _KeyQuarry_ObserveLeader_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTopic",
+			Handler:       _KeyQuarry_SubscribeTopic_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api/keyquarry.proto",
 }
@@ -60,6 +60,56 @@ func (c *Client) Prune(
 	return rv, err
 }
 
+func (c *Client) SetSchema(
+	ctx context.Context,
+	in *api.SetSchemaRequest,
+	opts ...grpc.CallOption,
+) (*api.SetSchemaResponse, error) {
+	logger := c.requestLogger(ctx)
+	logger.Info("setting schema", slog.String("prefix", in.Prefix))
+	opts = append(opts, c.callOpts...)
+	rv, err := c.adminClient.SetSchema(ctx, in, opts...)
+	logger.Info(
+		"set schema response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) DeleteSchema(
+	ctx context.Context,
+	in *api.DeleteSchemaRequest,
+	opts ...grpc.CallOption,
+) (*api.DeleteSchemaResponse, error) {
+	logger := c.requestLogger(ctx)
+	logger.Info("deleting schema", slog.String("prefix", in.Prefix))
+	opts = append(opts, c.callOpts...)
+	rv, err := c.adminClient.DeleteSchema(ctx, in, opts...)
+	logger.Info(
+		"delete schema response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ListSchemas(
+	ctx context.Context,
+	in *api.ListSchemasRequest,
+	opts ...grpc.CallOption,
+) (*api.ListSchemasResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.adminClient.ListSchemas(ctx, in, opts...)
+	logger.Debug(
+		"list schemas response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
 func (c *Client) Set(
 	ctx context.Context,
 	in *api.KeyValue,
@@ -368,6 +418,31 @@ func NewClientIDCredentials(clientID string) *ClientIDCredentials {
 	return &ClientIDCredentials{clientID: clientID}
 }
 
+// NamespaceCredentials implements the credentials.PerRPCCredentials
+// interface, adding the namespace to the request metadata.
+type NamespaceCredentials struct {
+	namespace string
+}
+
+func (c *NamespaceCredentials) GetRequestMetadata(
+	ctx context.Context,
+	uri ...string,
+) (map[string]string, error) {
+	return map[string]string{"namespace": c.namespace}, nil
+}
+
+func (c *NamespaceCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// WithNamespace returns a grpc.DialOption which scopes every request
+// to the given namespace
+func WithNamespace(namespace string) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(
+		&NamespaceCredentials{namespace: namespace},
+	)
+}
+
 func (c *Client) WatchKeyValue(
 	ctx context.Context,
 	in *api.WatchKeyValueRequest,
@@ -402,6 +477,23 @@ func (c *Client) WatchStream(
 	return stream, nil
 }
 
+func (c *Client) Scan(
+	ctx context.Context,
+	in *api.ScanRequest,
+) (api.KeyQuarry_ScanClient, error) {
+	logger := c.requestLogger(ctx)
+	opts := append(c.callOpts, grpc.MaxCallRecvMsgSize(1024*1024*1024))
+	stream, err := c.client.Scan(ctx, in, opts...)
+	if err != nil {
+		logger.Error(
+			"error creating scan stream",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return stream, nil
+}
+
 func (c *Client) Register(
 	ctx context.Context,
 	in *api.RegisterRequest,
@@ -418,6 +510,624 @@ func (c *Client) Register(
 	return rv, err
 }
 
+// KeepAlive opens a stream to renew leases granted by Register. Each
+// request sent on the stream renews the given lease.
+func (c *Client) KeepAlive(
+	ctx context.Context,
+) (api.KeyQuarry_KeepAliveClient, error) {
+	logger := c.requestLogger(ctx)
+	stream, err := c.client.KeepAlive(ctx, c.callOpts...)
+	if err != nil {
+		logger.Error(
+			"error creating keepalive stream",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (c *Client) Txn(
+	ctx context.Context,
+	in *api.TxnRequest,
+	opts ...grpc.CallOption,
+) (*api.TxnResponse, error) {
+	logger := c.requestLogger(ctx)
+	logger.Info(
+		"applying transaction",
+		slog.Int("guards", len(in.Guards)),
+		slog.Int("ops", len(in.Ops)),
+	)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.Txn(ctx, in, opts...)
+	logger.Debug(
+		"txn response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) BatchGet(
+	ctx context.Context,
+	in *api.BatchGetRequest,
+	opts ...grpc.CallOption,
+) (*api.BatchGetResponse, error) {
+	logger := c.requestLogger(ctx)
+	logger.Info("getting keys", slog.Int("count", len(in.Keys)))
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.BatchGet(ctx, in, opts...)
+	logger.Debug(
+		"batch get response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) BatchSet(
+	ctx context.Context,
+	in *api.BatchSetRequest,
+	opts ...grpc.CallOption,
+) (*api.BatchSetResponse, error) {
+	logger := c.requestLogger(ctx)
+	logger.Info("setting keys", slog.Int("count", len(in.Items)))
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.BatchSet(ctx, in, opts...)
+	logger.Debug(
+		"batch set response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) BatchDelete(
+	ctx context.Context,
+	in *api.BatchDeleteRequest,
+	opts ...grpc.CallOption,
+) (*api.BatchDeleteResponse, error) {
+	logger := c.requestLogger(ctx)
+	logger.Info("deleting keys", slog.Int("count", len(in.Items)))
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.BatchDelete(ctx, in, opts...)
+	logger.Debug(
+		"batch delete response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) Increment(
+	ctx context.Context,
+	in *api.IncrementRequest,
+	opts ...grpc.CallOption,
+) (*api.IncrementResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.Increment(ctx, in, opts...)
+	logger.Debug(
+		"increment response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) Rename(
+	ctx context.Context,
+	in *api.RenameRequest,
+	opts ...grpc.CallOption,
+) (*api.RenameResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.Rename(ctx, in, opts...)
+	logger.Debug(
+		"rename response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) Copy(
+	ctx context.Context,
+	in *api.CopyRequest,
+	opts ...grpc.CallOption,
+) (*api.CopyResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.Copy(ctx, in, opts...)
+	logger.Debug(
+		"copy response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) Persist(
+	ctx context.Context,
+	in *api.PersistRequest,
+	opts ...grpc.CallOption,
+) (*api.PersistResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.Persist(ctx, in, opts...)
+	logger.Debug(
+		"persist response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) Campaign(
+	ctx context.Context,
+	in *api.CampaignRequest,
+	opts ...grpc.CallOption,
+) (*api.CampaignResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.Campaign(ctx, in, opts...)
+	logger.Debug(
+		"campaign response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) Resign(
+	ctx context.Context,
+	in *api.ResignRequest,
+	opts ...grpc.CallOption,
+) (*api.ResignResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.Resign(ctx, in, opts...)
+	logger.Debug(
+		"resign response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) Leader(
+	ctx context.Context,
+	in *api.LeaderRequest,
+	opts ...grpc.CallOption,
+) (*api.LeaderResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.Leader(ctx, in, opts...)
+	logger.Debug(
+		"leader response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ObserveLeader(
+	ctx context.Context,
+	in *api.LeaderRequest,
+) (api.KeyQuarry_ObserveLeaderClient, error) {
+	logger := c.requestLogger(ctx)
+	stream, err := c.client.ObserveLeader(ctx, in, c.callOpts...)
+	if err != nil {
+		logger.Error(
+			"error creating leader observer stream",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (c *Client) AcquireSemaphore(
+	ctx context.Context,
+	in *api.AcquireSemaphoreRequest,
+	opts ...grpc.CallOption,
+) (*api.AcquireSemaphoreResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.AcquireSemaphore(ctx, in, opts...)
+	logger.Debug(
+		"acquire semaphore response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ReleaseSemaphore(
+	ctx context.Context,
+	in *api.ReleaseSemaphoreRequest,
+	opts ...grpc.CallOption,
+) (*api.ReleaseSemaphoreResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.ReleaseSemaphore(ctx, in, opts...)
+	logger.Debug(
+		"release semaphore response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ListPush(
+	ctx context.Context,
+	in *api.ListPushRequest,
+	opts ...grpc.CallOption,
+) (*api.ListPushResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.ListPush(ctx, in, opts...)
+	logger.Debug(
+		"list push response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ListPop(
+	ctx context.Context,
+	in *api.ListPopRequest,
+	opts ...grpc.CallOption,
+) (*api.ListPopResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.ListPop(ctx, in, opts...)
+	logger.Debug(
+		"list pop response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ListRange(
+	ctx context.Context,
+	in *api.ListRangeRequest,
+	opts ...grpc.CallOption,
+) (*api.ListRangeResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.ListRange(ctx, in, opts...)
+	logger.Debug(
+		"list range response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ListLen(
+	ctx context.Context,
+	in *api.Key,
+	opts ...grpc.CallOption,
+) (*api.ListLenResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.ListLen(ctx, in, opts...)
+	logger.Debug(
+		"list length response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) HSet(
+	ctx context.Context,
+	in *api.HSetRequest,
+	opts ...grpc.CallOption,
+) (*api.HSetResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.HSet(ctx, in, opts...)
+	logger.Debug(
+		"hash set response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) HGet(
+	ctx context.Context,
+	in *api.HGetRequest,
+	opts ...grpc.CallOption,
+) (*api.HGetResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.HGet(ctx, in, opts...)
+	logger.Debug(
+		"hash get response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) HDel(
+	ctx context.Context,
+	in *api.HDelRequest,
+	opts ...grpc.CallOption,
+) (*api.HDelResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.HDel(ctx, in, opts...)
+	logger.Debug(
+		"hash delete response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) HGetAll(
+	ctx context.Context,
+	in *api.Key,
+	opts ...grpc.CallOption,
+) (*api.HGetAllResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.HGetAll(ctx, in, opts...)
+	logger.Debug(
+		"hash get all response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) HIncrBy(
+	ctx context.Context,
+	in *api.HIncrByRequest,
+	opts ...grpc.CallOption,
+) (*api.HIncrByResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.HIncrBy(ctx, in, opts...)
+	logger.Debug(
+		"hash increment response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) SAdd(
+	ctx context.Context,
+	in *api.SetMembersRequest,
+	opts ...grpc.CallOption,
+) (*api.SAddResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.SAdd(ctx, in, opts...)
+	logger.Debug(
+		"set add response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) SRem(
+	ctx context.Context,
+	in *api.SetMembersRequest,
+	opts ...grpc.CallOption,
+) (*api.SRemResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.SRem(ctx, in, opts...)
+	logger.Debug(
+		"set remove response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) SMembers(
+	ctx context.Context,
+	in *api.Key,
+	opts ...grpc.CallOption,
+) (*api.SMembersResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.SMembers(ctx, in, opts...)
+	logger.Debug(
+		"set members response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) SIsMember(
+	ctx context.Context,
+	in *api.SIsMemberRequest,
+	opts ...grpc.CallOption,
+) (*api.SIsMemberResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.SIsMember(ctx, in, opts...)
+	logger.Debug(
+		"set is member response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ZAdd(
+	ctx context.Context,
+	in *api.ZAddRequest,
+	opts ...grpc.CallOption,
+) (*api.ZAddResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.ZAdd(ctx, in, opts...)
+	logger.Debug(
+		"sorted set add response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ZRangeByScore(
+	ctx context.Context,
+	in *api.ZRangeByScoreRequest,
+	opts ...grpc.CallOption,
+) (*api.ZMembersResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.ZRangeByScore(ctx, in, opts...)
+	logger.Debug(
+		"sorted set range response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ZRem(
+	ctx context.Context,
+	in *api.SetMembersRequest,
+	opts ...grpc.CallOption,
+) (*api.ZRemResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.ZRem(ctx, in, opts...)
+	logger.Debug(
+		"sorted set remove response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ZPopMin(
+	ctx context.Context,
+	in *api.ZPopMinRequest,
+	opts ...grpc.CallOption,
+) (*api.ZMembersResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.ZPopMin(ctx, in, opts...)
+	logger.Debug(
+		"sorted set pop response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) Publish(
+	ctx context.Context,
+	in *api.PublishRequest,
+	opts ...grpc.CallOption,
+) (*api.PublishResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.Publish(ctx, in, opts...)
+	logger.Debug(
+		"publish response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) SubscribeTopic(
+	ctx context.Context,
+	in *api.SubscribeTopicRequest,
+) (api.KeyQuarry_SubscribeTopicClient, error) {
+	logger := c.requestLogger(ctx)
+	opts := append(c.callOpts, grpc.MaxCallRecvMsgSize(1024*1024*1024))
+	stream, err := c.client.SubscribeTopic(ctx, in, opts...)
+	if err != nil {
+		logger.Error(
+			"error creating topic subscription",
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (c *Client) JSONGet(
+	ctx context.Context,
+	in *api.JSONGetRequest,
+	opts ...grpc.CallOption,
+) (*api.JSONGetResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.JSONGet(ctx, in, opts...)
+	logger.Debug(
+		"json get response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) JSONPatch(
+	ctx context.Context,
+	in *api.JSONPatchRequest,
+	opts ...grpc.CallOption,
+) (*api.JSONPatchResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.JSONPatch(ctx, in, opts...)
+	logger.Debug(
+		"json patch response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) ListRevisions(
+	ctx context.Context,
+	in *api.Key,
+	opts ...grpc.CallOption,
+) (*api.ListRevisionsResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.ListRevisions(ctx, in, opts...)
+	logger.Debug(
+		"list revisions response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
+func (c *Client) Rollback(
+	ctx context.Context,
+	in *api.RollbackRequest,
+	opts ...grpc.CallOption,
+) (*api.RollbackResponse, error) {
+	logger := c.requestLogger(ctx)
+	opts = append(opts, c.callOpts...)
+	rv, err := c.client.Rollback(ctx, in, opts...)
+	logger.Debug(
+		"rollback response",
+		slog.Any("response", rv),
+		slog.Any("error", err),
+	)
+	return rv, err
+}
+
 func (c *Client) CloseConnection() error {
 	if c.conn != nil {
 		if e := c.conn.Close(); e != nil {
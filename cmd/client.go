@@ -143,6 +143,13 @@ var clientCmd = &cobra.Command{
 			}
 		}
 
+		if opts.clientOpts.Namespace != "" {
+			dialOpts = append(
+				dialOpts,
+				client.WithNamespace(opts.clientOpts.Namespace),
+			)
+		}
+
 		kvClient := opts.client
 		if kvClient == nil {
 			kvClient = client.New(
@@ -224,6 +231,12 @@ func init() {
 		clientID,
 		"Set the client ID (default: {user}@{host}). Identifies the client to the server for updates, unlocking, etc.",
 	)
+	clientCmd.PersistentFlags().StringVar(
+		&cliOpts.clientOpts.Namespace,
+		"namespace",
+		"",
+		"Namespace to scope keys to. If not set, the default namespace is used.",
+	)
 	clientCmd.PersistentFlags().StringVar(
 		&cliOpts.clientOpts.CACert,
 		"ca-certfile",
@@ -13,9 +13,10 @@ import (
 	"time"
 )
 
-type setResult struct {
-	Key    string
-	Result *pb.SetResponse
+// batchSetResult is the result of a BatchSet request for a chunk of items
+type batchSetResult struct {
+	Items  []*pb.KeyValue
+	Result *pb.BatchSetResponse
 	Error  error
 }
 
@@ -41,7 +42,7 @@ var bulkSetCmd = &cobra.Command{
 		opts := &cliOpts
 		cfg := opts.clientOpts
 		pending := make([]*pb.KeyValue, 0, len(args))
-		doneChannel := make(chan setResult)
+		doneChannel := make(chan batchSetResult)
 
 		var lockDuration *durationpb.Duration
 		if cfg.LockTimeout > 0 {
@@ -69,22 +70,32 @@ var bulkSetCmd = &cobra.Command{
 			)
 		}
 
+		chunkSize := cfg.LoadChunkSize
+		if chunkSize <= 0 {
+			chunkSize = len(pending)
+		}
+
+		// Each chunk is sent with a single BatchSet request
 		workers := runtime.GOMAXPROCS(0)
-		sendChannel := make(chan *pb.KeyValue)
+		sendChannel := make(chan []*pb.KeyValue)
 		wg := sync.WaitGroup{}
 		for w := 0; w < workers; w++ {
 			wg.Add(1)
 			go func() {
 				defer wg.Done()
-				for pk := range sendChannel {
+				for chunk := range sendChannel {
 					if ctx.Err() != nil {
 						return
 					}
-					rv := setResult{Key: pk.Key}
-					res, err := opts.client.Set(ctx, pk)
-					rv.Result = res
-					rv.Error = err
-					doneChannel <- rv
+					res, err := opts.client.BatchSet(
+						ctx,
+						&pb.BatchSetRequest{Items: chunk},
+					)
+					doneChannel <- batchSetResult{
+						Items:  chunk,
+						Result: res,
+						Error:  err,
+					}
 				}
 			}()
 		}
@@ -92,35 +103,40 @@ var bulkSetCmd = &cobra.Command{
 		start := time.Now()
 
 		go func() {
-			for _, k := range pending {
+			for i := 0; i < len(pending); i += chunkSize {
 				if ctx.Err() != nil {
-					return
+					break
 				}
-				k := k
-				sendChannel <- k
+				sendChannel <- pending[i:min(i+chunkSize, len(pending))]
 			}
 			close(sendChannel)
 		}()
 
-		var secs float64
 		go func() {
 			wg.Wait()
 			close(doneChannel)
-			secs = time.Since(start).Seconds()
 		}()
 
 		for result := range doneChannel {
-			switch {
-			case result.Error == nil:
-				fmt.Printf("%s: %+v\n", result.Key, result.Result)
-			default:
-				fmt.Printf("error: %s: %s\n", result.Key, result.Error)
+			if result.Error != nil {
+				for _, item := range result.Items {
+					fmt.Printf("error: %s: %s\n", item.Key, result.Error)
+				}
+				continue
+			}
+			for _, r := range result.Result.Results {
+				switch {
+				case r.Error == nil:
+					fmt.Printf("%s: %+v\n", r.Key, r.Result)
+				default:
+					fmt.Printf("error: %s: %s\n", r.Key, r.Error.Message)
+				}
 			}
 		}
 		defaultLogger.Info(
 			"finished processing",
 			slog.Int("processed", len(args)),
-			slog.Float64("seconds", secs),
+			slog.Float64("seconds", time.Since(start).Seconds()),
 		)
 		return nil
 	},
@@ -140,5 +156,11 @@ func init() {
 		0,
 		"Lock duration (ex: 15m)",
 	)
+	bulkSetCmd.Flags().IntVar(
+		&cliOpts.clientOpts.LoadChunkSize,
+		"chunk-size",
+		1000,
+		"Number of keys to send per request (0 to send all keys at once)",
+	)
 
 }
@@ -14,7 +14,14 @@ var deleteCmd = &cobra.Command{
 
 		key := args[0]
 		opts := &cliOpts
-		kv, err := opts.client.Delete(ctx, &pb.DeleteRequest{Key: key})
+		req := &pb.DeleteRequest{Key: key}
+
+		var err error
+		req.Mode, req.Version, req.Hash, err = writeCondition(cmd)
+		printError(err)
+		req.FencingToken = fencingToken(cmd)
+
+		kv, err := opts.client.Delete(ctx, req)
 		printError(err)
 		printResult(kv)
 	},
@@ -22,4 +29,22 @@ var deleteCmd = &cobra.Command{
 
 func init() {
 	clientCmd.AddCommand(deleteCmd)
+	deleteCmd.Flags().Uint64Var(
+		&cliOpts.clientOpts.WriteOpts.IfVersion,
+		"if-version",
+		0,
+		"Only delete the key if its current version matches",
+	)
+	deleteCmd.Flags().Uint64Var(
+		&cliOpts.clientOpts.WriteOpts.IfHash,
+		"if-hash",
+		0,
+		"Only delete the key if its current hash matches",
+	)
+	deleteCmd.Flags().Uint64Var(
+		&cliOpts.clientOpts.FencingToken,
+		"fencing-token",
+		0,
+		"Fencing token from a lock on the key, rejected if a newer lock was acquired since",
+	)
 }
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"time"
+)
+
+var electionCmd = &cobra.Command{
+	Use:   "election",
+	Short: "Leader election commands",
+}
+
+var campaignCmd = &cobra.Command{
+	Use:   "campaign [flags] [name] [value]",
+	Short: "Campaigns to become the leader of an election, waiting until elected",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		req := &pb.CampaignRequest{
+			Name:  args[0],
+			Value: []byte(args[1]),
+			Lease: durationpb.New(opts.clientOpts.ElectionOpts.Lease),
+		}
+		if opts.clientOpts.ElectionOpts.Wait > 0 {
+			req.WaitTimeout = durationpb.New(opts.clientOpts.ElectionOpts.Wait)
+		}
+		rv, err := opts.client.Campaign(ctx, req)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var resignCmd = &cobra.Command{
+	Use:   "resign [name]",
+	Short: "Resigns leadership of an election",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.Resign(ctx, &pb.ResignRequest{Name: args[0]})
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var leaderCmd = &cobra.Command{
+	Use:   "leader [name]",
+	Short: "Shows the current leader of an election",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.Leader(ctx, &pb.LeaderRequest{Name: args[0]})
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var observeCmd = &cobra.Command{
+	Use:   "observe [name]",
+	Short: "Watches for changes in the leader of an election",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		client := opts.client
+		stream, e := client.ObserveLeader(
+			ctx,
+			&pb.LeaderRequest{Name: args[0]},
+		)
+		printError(e)
+		defer func() {
+			_ = client.CloseConnection()
+		}()
+
+		for {
+			if ctx.Err() != nil {
+				break
+			}
+			leader, err := stream.Recv()
+			printError(err)
+			if leader != nil {
+				fmt.Printf("%s\n", leader)
+			}
+		}
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(electionCmd)
+	electionCmd.AddCommand(campaignCmd, resignCmd, leaderCmd, observeCmd)
+	campaignCmd.Flags().DurationVar(
+		&cliOpts.clientOpts.ElectionOpts.Lease,
+		"lease",
+		30*time.Second,
+		"How long leadership lasts, unless renewed by campaigning again",
+	)
+	campaignCmd.Flags().DurationVar(
+		&cliOpts.clientOpts.ElectionOpts.Wait,
+		"wait",
+		0,
+		"Give up if not elected within the specified duration (e.g. 30s)",
+	)
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+	"strconv"
+	"strings"
+)
+
+var hsetCmd = &cobra.Command{
+	Use:          "hset [key] [field=value...]",
+	Short:        "Sets fields of a hash",
+	Args:         cobra.MinimumNArgs(2),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		fields := make(map[string][]byte, len(args)-1)
+		for _, arg := range args[1:] {
+			field, value, found := strings.Cut(arg, "=")
+			if !found {
+				return fmt.Errorf("invalid field '%s', expected field=value", arg)
+			}
+			fields[field] = []byte(value)
+		}
+		opts := &cliOpts
+		rv, err := opts.client.HSet(
+			ctx,
+			&pb.HSetRequest{Key: args[0], Fields: fields},
+		)
+		printError(err)
+		printResult(rv)
+		return nil
+	},
+}
+
+var hgetCmd = &cobra.Command{
+	Use:   "hget [key] [field]",
+	Short: "Gets the value of a field of a hash",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.HGet(
+			ctx,
+			&pb.HGetRequest{Key: args[0], Field: args[1]},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var hdelCmd = &cobra.Command{
+	Use:   "hdel [key] [fields...]",
+	Short: "Deletes fields from a hash",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.HDel(
+			ctx,
+			&pb.HDelRequest{Key: args[0], Fields: args[1:]},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var hgetallCmd = &cobra.Command{
+	Use:   "hgetall [key]",
+	Short: "Gets all the fields of a hash",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.HGetAll(ctx, &pb.Key{Key: args[0]})
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var hincrbyCmd = &cobra.Command{
+	Use:          "hincrby [key] [field] [amount]",
+	Short:        "Adds to the integer value of a field of a hash",
+	Args:         cobra.ExactArgs(3),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		amount, err := strconv.ParseInt(args[2], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid amount '%s': %w", args[2], err)
+		}
+		opts := &cliOpts
+		rv, err := opts.client.HIncrBy(
+			ctx,
+			&pb.HIncrByRequest{Key: args[0], Field: args[1], Amount: amount},
+		)
+		printError(err)
+		printResult(rv)
+		return nil
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(hsetCmd, hgetCmd, hdelCmd, hgetallCmd, hincrbyCmd)
+}
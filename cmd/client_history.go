@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "Key revision history commands",
+}
+
+var listRevisionsCmd = &cobra.Command{
+	Use:   "list [key]",
+	Short: "Lists the revisions of a key, oldest first, one per line (version, timestamp, size, content type and hash, tab-separated)",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.ListRevisions(ctx, &pb.Key{Key: args[0]})
+		printError(err)
+		for _, rev := range rv.Revisions {
+			_, err = fmt.Fprintf(
+				out,
+				"%d\t%s\t%d\t%s\t%d\n",
+				rev.Version,
+				rev.Timestamp.AsTime().Format(time.RFC3339),
+				rev.Size,
+				rev.ContentType,
+				rev.Hash,
+			)
+			printError(err)
+		}
+	},
+}
+
+var diffRevisionsCmd = &cobra.Command{
+	Use:   "diff [key] [from] [to]",
+	Short: "Shows a unified diff between two text revisions of a key. If the second version isn't provided, the first is compared with the current value.",
+	Long: "Shows a unified diff between two text revisions of a key. If the second " +
+		"version isn't provided, the first is compared with the current value. " +
+		"As with 'get --revision', a negative version specifies the number of " +
+		"versions back from the latest.",
+	Args: cobra.RangeArgs(2, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		key := args[0]
+
+		getRevision := func(arg string) ([]byte, string) {
+			version, err := strconv.ParseInt(arg, 10, 64)
+			printError(err)
+			rv, err := opts.client.GetRevision(
+				ctx,
+				&pb.GetRevisionRequest{Key: key, Version: version},
+			)
+			printError(err)
+			return rv.Value, fmt.Sprintf("%s@%d", key, version)
+		}
+
+		fromValue, fromName := getRevision(args[1])
+		var toValue []byte
+		var toName string
+		switch len(args) {
+		case 3:
+			toValue, toName = getRevision(args[2])
+		default:
+			kv, err := opts.client.Get(ctx, &pb.Key{Key: key})
+			printError(err)
+			toValue, toName = kv.Value, key
+		}
+
+		for _, value := range [][]byte{fromValue, toValue} {
+			if !utf8.Valid(value) || bytes.IndexByte(value, 0) != -1 {
+				printError(fmt.Errorf("revisions of '%s' aren't text", key))
+			}
+		}
+
+		_, err := fmt.Fprint(
+			out,
+			unifiedDiff(fromName, toName, string(fromValue), string(toValue)),
+		)
+		printError(err)
+	},
+}
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback [key] [version]",
+	Short: "Sets the value of a key to the value of one of its revisions, as a new version",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		printError(err)
+		opts := &cliOpts
+		rv, err := opts.client.Rollback(
+			ctx,
+			&pb.RollbackRequest{Key: args[0], Version: version},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(historyCmd)
+	historyCmd.AddCommand(listRevisionsCmd, diffRevisionsCmd, rollbackCmd)
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"strconv"
+)
+
+var incrementCmd = &cobra.Command{
+	Use:   "incr [key] [amount]",
+	Short: "Increments the integer value of a key, creating it if it doesn't exist",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		increment(cmd, args, 1)
+	},
+}
+
+var decrementCmd = &cobra.Command{
+	Use:   "decr [key] [amount]",
+	Short: "Decrements the integer value of a key, creating it if it doesn't exist",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		increment(cmd, args, -1)
+	},
+}
+
+// increment sends an Increment request for the key and (optional, default
+// 1) amount in args, multiplied by sign, and prints the new value
+func increment(cmd *cobra.Command, args []string, sign int64) {
+	ctx := cmd.Context()
+	opts := &cliOpts
+
+	var amount int64 = 1
+	if len(args) == 2 {
+		var err error
+		amount, err = strconv.ParseInt(args[1], 10, 64)
+		printError(err)
+	}
+	amount *= sign
+
+	req := &pb.IncrementRequest{Key: args[0], Amount: &amount}
+	if opts.clientOpts.KeyLifespan.Seconds() > 0 {
+		req.Lifespan = durationpb.New(opts.clientOpts.KeyLifespan)
+	}
+
+	rv, err := opts.client.Increment(ctx, req)
+	printError(err)
+	_, err = fmt.Fprintln(out, rv.Value)
+	printError(err)
+}
+
+func init() {
+	clientCmd.AddCommand(incrementCmd)
+	clientCmd.AddCommand(decrementCmd)
+	for _, c := range []*cobra.Command{incrementCmd, decrementCmd} {
+		c.Flags().DurationVar(
+			&cliOpts.clientOpts.KeyLifespan,
+			"lifespan",
+			0,
+			"Expire key in specified duration (e.g. 1h30m)",
+		)
+	}
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+)
+
+var jsonGetCmd = &cobra.Command{
+	Use:   "json-get [key] [path]",
+	Short: "Gets the part of a JSON value selected by a JSONPath expression (ex: $.a.b[0])",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		req := &pb.JSONGetRequest{Key: args[0]}
+		if len(args) == 2 {
+			req.Path = args[1]
+		}
+		rv, err := opts.client.JSONGet(ctx, req)
+		printError(err)
+		_, err = fmt.Fprintln(out, string(rv.Value))
+		printError(err)
+	},
+}
+
+var jsonPatchCmd = &cobra.Command{
+	Use:   "json-patch [key] [patch]",
+	Short: "Applies a JSON Patch (RFC 6902) to a JSON value",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.JSONPatch(
+			ctx,
+			&pb.JSONPatchRequest{Key: args[0], Patch: []byte(args[1])},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(jsonGetCmd, jsonPatchCmd)
+}
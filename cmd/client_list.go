@@ -7,29 +7,52 @@ import (
 	"log"
 )
 
+// defaultListPageSize is the page size used by `list --all` when
+// no limit is provided
+const defaultListPageSize = 1000
+
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Outputs a list of keys",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
 		opts := &cliOpts
-		kv, err := opts.client.ListKeys(
-			ctx, &api.ListKeysRequest{
-				Pattern:         opts.clientOpts.ListKeyOpts.Pattern,
-				Limit:           opts.clientOpts.ListKeyOpts.Limit,
-				IncludeReserved: opts.clientOpts.ListKeyOpts.IncludeReserved,
-			},
-		)
-		if err != nil {
-			return err
+		listOpts := opts.clientOpts.ListKeyOpts
+
+		req := &api.ListKeysRequest{
+			Pattern:         listOpts.Pattern,
+			Prefix:          listOpts.Prefix,
+			Limit:           listOpts.Limit,
+			IncludeReserved: listOpts.IncludeReserved,
+			StartAfter:      listOpts.StartAfter,
+			PageToken:       listOpts.PageToken,
+			Selector:        listOpts.Selector,
 		}
-		log.Printf("keys: %#v", kv)
-		for _, k := range kv.Keys {
-			_, err = fmt.Fprintln(out, k)
-			printError(err)
+		if listOpts.All && req.Limit == 0 {
+			req.Limit = defaultListPageSize
 		}
 
-		return nil
+		for {
+			kv, err := opts.client.ListKeys(ctx, req)
+			if err != nil {
+				return err
+			}
+			log.Printf("keys: %#v", kv)
+			for _, k := range kv.Keys {
+				_, err = fmt.Fprintln(out, k)
+				printError(err)
+			}
+
+			if kv.NextPageToken == "" {
+				return nil
+			}
+			if !listOpts.All {
+				log.Printf("next page token: %s", kv.NextPageToken)
+				return nil
+			}
+			req.StartAfter = ""
+			req.PageToken = kv.NextPageToken
+		}
 	},
 }
 
@@ -41,11 +64,17 @@ func init() {
 		"",
 		"pattern to match keys against",
 	)
+	listCmd.Flags().StringVar(
+		&cliOpts.clientOpts.ListKeyOpts.Prefix,
+		"prefix",
+		"",
+		"only list keys beginning with this prefix",
+	)
 	listCmd.Flags().Uint64Var(
 		&cliOpts.clientOpts.ListKeyOpts.Limit,
 		"limit",
 		0,
-		"limit the number of keys returned",
+		"limit the number of keys returned (the page size, with --all)",
 	)
 	listCmd.Flags().BoolVar(
 		&cliOpts.clientOpts.ListKeyOpts.IncludeReserved,
@@ -53,4 +82,29 @@ func init() {
 		false,
 		"include reserved keys in list",
 	)
+	listCmd.Flags().StringVar(
+		&cliOpts.clientOpts.ListKeyOpts.StartAfter,
+		"start-after",
+		"",
+		"only list keys sorted after this key",
+	)
+	listCmd.Flags().StringVar(
+		&cliOpts.clientOpts.ListKeyOpts.PageToken,
+		"page-token",
+		"",
+		"page token from a previous list, to continue from",
+	)
+	listCmd.Flags().StringVarP(
+		&cliOpts.clientOpts.ListKeyOpts.Selector,
+		"selector",
+		"l",
+		"",
+		"only list keys with labels matching this selector (ex: env=prod)",
+	)
+	listCmd.Flags().BoolVar(
+		&cliOpts.clientOpts.ListKeyOpts.All,
+		"all",
+		false,
+		"list every matching key, retrieving as many pages as needed",
+	)
 }
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"strconv"
+)
+
+// listPushCmd returns a command to push values to the given end of a list
+func listPushCmd(use string, end pb.ListEnd) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " [key] [values...]",
+		Short: fmt.Sprintf("Pushes values to the %s of a list", listEndName(end)),
+		Args:  cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			opts := &cliOpts
+			values := make([][]byte, 0, len(args)-1)
+			for _, v := range args[1:] {
+				values = append(values, []byte(v))
+			}
+			rv, err := opts.client.ListPush(
+				ctx,
+				&pb.ListPushRequest{Key: args[0], Values: values, End: end},
+			)
+			printError(err)
+			printResult(rv)
+		},
+	}
+}
+
+// listPopCmd returns a command to pop a value from the given end of a list
+func listPopCmd(use string, end pb.ListEnd) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [flags] [key]",
+		Short: fmt.Sprintf("Pops a value from the %s of a list", listEndName(end)),
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx := cmd.Context()
+			opts := &cliOpts
+			req := &pb.ListPopRequest{Key: args[0], End: end}
+			if opts.clientOpts.ListBlock > 0 {
+				req.BlockTimeout = durationpb.New(opts.clientOpts.ListBlock)
+			}
+			rv, err := opts.client.ListPop(ctx, req)
+			printError(err)
+			printResult(rv)
+		},
+	}
+	cmd.Flags().DurationVar(
+		&cliOpts.clientOpts.ListBlock,
+		"block",
+		0,
+		"Wait up to the specified duration for a value, if the list is empty (e.g. 30s)",
+	)
+	return cmd
+}
+
+func listEndName(end pb.ListEnd) string {
+	if end == pb.ListEnd_RIGHT {
+		return "end"
+	}
+	return "start"
+}
+
+var lrangeCmd = &cobra.Command{
+	Use:          "lrange [key] [start] [stop]",
+	Short:        "Gets the values of a list between two indexes (inclusive)",
+	Args:         cobra.ExactArgs(3),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		start, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid start '%s': %w", args[1], err)
+		}
+		stop, err := strconv.ParseInt(args[2], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid stop '%s': %w", args[2], err)
+		}
+		opts := &cliOpts
+		rv, err := opts.client.ListRange(
+			ctx,
+			&pb.ListRangeRequest{Key: args[0], Start: start, Stop: stop},
+		)
+		printError(err)
+		printResult(rv)
+		return nil
+	},
+}
+
+var llenCmd = &cobra.Command{
+	Use:   "llen [key]",
+	Short: "Gets the number of values in a list",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.ListLen(ctx, &pb.Key{Key: args[0]})
+		printError(err)
+		printResult(rv)
+	},
+}
+
+func init() {
+	// Stop parsing flags after the key, so negative indexes aren't
+	// mistaken for flags
+	lrangeCmd.Flags().SetInterspersed(false)
+	clientCmd.AddCommand(
+		listPushCmd("lpush", pb.ListEnd_LEFT),
+		listPushCmd("rpush", pb.ListEnd_RIGHT),
+		listPopCmd("lpop", pb.ListEnd_LEFT),
+		listPopCmd("rpop", pb.ListEnd_RIGHT),
+		lrangeCmd,
+		llenCmd,
+	)
+}
@@ -24,14 +24,22 @@ var lockCmd = &cobra.Command{
 
 		opts := &cliOpts
 
-		kv, err := opts.client.Lock(
-			ctx,
-			&pb.LockRequest{
-				Key:             key,
-				Duration:        durationpb.New(d),
-				CreateIfMissing: opts.clientOpts.LockCreateIfMissing,
-			},
-		)
+		mode := pb.LockMode_EXCLUSIVE
+		if opts.clientOpts.LockShared {
+			mode = pb.LockMode_SHARED
+		}
+
+		req := &pb.LockRequest{
+			Key:             key,
+			Duration:        durationpb.New(d),
+			CreateIfMissing: opts.clientOpts.LockCreateIfMissing,
+			Mode:            mode,
+		}
+		if opts.clientOpts.LockWait > 0 {
+			req.WaitTimeout = durationpb.New(opts.clientOpts.LockWait)
+		}
+
+		kv, err := opts.client.Lock(ctx, req)
 		printError(err)
 		printResult(kv)
 		return nil
@@ -46,4 +54,16 @@ func init() {
 		false,
 		"Create the key if it does not exist",
 	)
+	lockCmd.Flags().BoolVar(
+		&cliOpts.clientOpts.LockShared,
+		"shared",
+		false,
+		"Acquire a shared lock, which allows other clients to read the key",
+	)
+	lockCmd.Flags().DurationVar(
+		&cliOpts.clientOpts.LockWait,
+		"wait",
+		0,
+		"Wait up to the specified duration for the lock, if another client holds it (e.g. 30s)",
+	)
 }
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+)
+
+var persistCmd = &cobra.Command{
+	Use:   "persist [key]",
+	Short: "Removes the lifespan of a key, so it won't expire",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.Persist(ctx, &pb.PersistRequest{Key: args[0]})
+		printError(err)
+		printResult(rv)
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(persistCmd)
+}
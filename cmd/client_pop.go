@@ -15,7 +15,10 @@ var popCmd = &cobra.Command{
 
 		key := args[0]
 		opts := &cliOpts
-		kv, err := opts.client.Pop(ctx, &pb.PopRequest{Key: key})
+		kv, err := opts.client.Pop(
+			ctx,
+			&pb.PopRequest{Key: key, FencingToken: fencingToken(cmd)},
+		)
 		printError(err)
 		_, err = fmt.Fprintln(out, string(kv.Value))
 		printError(err)
@@ -24,4 +27,10 @@ var popCmd = &cobra.Command{
 
 func init() {
 	clientCmd.AddCommand(popCmd)
+	popCmd.Flags().Uint64Var(
+		&cliOpts.clientOpts.FencingToken,
+		"fencing-token",
+		0,
+		"Fencing token from a lock on the key, rejected if a newer lock was acquired since",
+	)
 }
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+)
+
+var renameCmd = &cobra.Command{
+	Use:   "rename [source] [destination]",
+	Short: "Rename a key, keeping its value, content type and lifespan",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+
+		rv, err := opts.client.Rename(
+			ctx,
+			&pb.RenameRequest{
+				Source:         args[0],
+				Destination:    args[1],
+				Overwrite:      opts.clientOpts.CopyOpts.Overwrite,
+				IncludeHistory: opts.clientOpts.CopyOpts.IncludeHistory,
+			},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var copyCmd = &cobra.Command{
+	Use:   "copy [source] [destination]",
+	Short: "Copy a key's value, content type and lifespan to another key",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+
+		rv, err := opts.client.Copy(
+			ctx,
+			&pb.CopyRequest{
+				Source:         args[0],
+				Destination:    args[1],
+				Overwrite:      opts.clientOpts.CopyOpts.Overwrite,
+				IncludeHistory: opts.clientOpts.CopyOpts.IncludeHistory,
+			},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(renameCmd)
+	clientCmd.AddCommand(copyCmd)
+	for _, c := range []*cobra.Command{renameCmd, copyCmd} {
+		c.Flags().BoolVar(
+			&cliOpts.clientOpts.CopyOpts.Overwrite,
+			"overwrite",
+			false,
+			"Replace the destination key if it already exists",
+		)
+		c.Flags().BoolVar(
+			&cliOpts.clientOpts.CopyOpts.IncludeHistory,
+			"history",
+			false,
+			"Include the source key's revision history",
+		)
+	}
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"errors"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+	"io"
+)
+
+var scanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "Outputs keys in a range, with their values and metadata",
+	Long: `Outputs keys in a range and/or with a prefix, sorted, along with
+their values and metadata, one JSON object per line.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		scanOpts := opts.clientOpts.ScanOpts
+
+		stream, err := opts.client.Scan(
+			ctx,
+			&pb.ScanRequest{
+				Prefix:          scanOpts.Prefix,
+				Start:           scanOpts.Start,
+				End:             scanOpts.End,
+				Limit:           scanOpts.Limit,
+				IncludeReserved: scanOpts.IncludeReserved,
+				IncludeLocked:   scanOpts.IncludeLocked,
+			},
+		)
+		printError(err)
+
+		for {
+			result, e := stream.Recv()
+			if errors.Is(e, io.EOF) {
+				return
+			}
+			printError(e)
+			printResult(result)
+		}
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(scanCmd)
+	scanCmd.Flags().StringVar(
+		&cliOpts.clientOpts.ScanOpts.Prefix,
+		"prefix",
+		"",
+		"only include keys beginning with this prefix",
+	)
+	scanCmd.Flags().StringVar(
+		&cliOpts.clientOpts.ScanOpts.Start,
+		"start",
+		"",
+		"only include keys sorted at or after this key",
+	)
+	scanCmd.Flags().StringVar(
+		&cliOpts.clientOpts.ScanOpts.End,
+		"end",
+		"",
+		"only include keys sorted before this key",
+	)
+	scanCmd.Flags().Uint64Var(
+		&cliOpts.clientOpts.ScanOpts.Limit,
+		"limit",
+		0,
+		"limit the number of keys returned",
+	)
+	scanCmd.Flags().BoolVar(
+		&cliOpts.clientOpts.ScanOpts.IncludeReserved,
+		"include-reserved",
+		false,
+		"include reserved keys",
+	)
+	scanCmd.Flags().BoolVar(
+		&cliOpts.clientOpts.ScanOpts.IncludeLocked,
+		"include-locked",
+		false,
+		"include keys locked by other clients (without their values)",
+	)
+}
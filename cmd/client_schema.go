@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+	"strings"
+)
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "JSON Schema commands (requires the privileged client ID)",
+}
+
+var setSchemaCmd = &cobra.Command{
+	Use:   "set [prefix] [schema]",
+	Short: "Binds a JSON Schema to a key prefix. If the schema isn't provided, it's read from stdin.",
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		var schema []byte
+		switch len(args) {
+		case 2:
+			schema = []byte(args[1])
+		default:
+			val, err := readStdin()
+			printError(err)
+			schema = []byte(strings.Join(val, "\n"))
+		}
+
+		opts := &cliOpts
+		rv, err := opts.client.SetSchema(
+			ctx,
+			&pb.SetSchemaRequest{Prefix: args[0], Schema: schema},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var deleteSchemaCmd = &cobra.Command{
+	Use:   "delete [prefix]",
+	Short: "Removes the JSON Schema bound to a key prefix",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.DeleteSchema(
+			ctx,
+			&pb.DeleteSchemaRequest{Prefix: args[0]},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var listSchemasCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the JSON Schemas bound to key prefixes, one per line (prefix, then schema, tab-separated)",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.ListSchemas(ctx, &pb.ListSchemasRequest{})
+		printError(err)
+		for _, ks := range rv.Schemas {
+			_, err = fmt.Fprintf(out, "%s\t%s\n", ks.Prefix, ks.Schema)
+			printError(err)
+		}
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(schemaCmd)
+	schemaCmd.AddCommand(setSchemaCmd, deleteSchemaCmd, listSchemasCmd)
+}
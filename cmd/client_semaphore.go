@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"strconv"
+	"time"
+)
+
+var semaphoreCmd = &cobra.Command{
+	Use:   "semaphore",
+	Short: "Semaphore commands",
+}
+
+var acquireSemaphoreCmd = &cobra.Command{
+	Use:          "acquire [key] [permits_max] [ttl]",
+	Short:        "Acquires (or renews) a permit for the semaphore on a key",
+	Args:         cobra.ExactArgs(3),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		permitsMax, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid permits_max '%s': %w", args[1], err)
+		}
+		ttl, err := time.ParseDuration(args[2])
+		if err != nil {
+			return fmt.Errorf("invalid ttl '%s': %w", args[2], err)
+		}
+
+		opts := &cliOpts
+		rv, err := opts.client.AcquireSemaphore(
+			ctx,
+			&pb.AcquireSemaphoreRequest{
+				Name:       args[0],
+				PermitsMax: uint32(permitsMax),
+				Ttl:        durationpb.New(ttl),
+			},
+		)
+		printError(err)
+		printResult(rv)
+		return nil
+	},
+}
+
+var releaseSemaphoreCmd = &cobra.Command{
+	Use:   "release [key]",
+	Short: "Releases the client's permit for the semaphore on a key",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.ReleaseSemaphore(
+			ctx,
+			&pb.ReleaseSemaphoreRequest{Name: args[0]},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(semaphoreCmd)
+	semaphoreCmd.AddCommand(acquireSemaphoreCmd, releaseSemaphoreCmd)
+}
@@ -4,7 +4,9 @@ import (
 	pb "github.com/arcward/keyquarry/api"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"strings"
+	"time"
 )
 
 var setCmd = &cobra.Command{
@@ -37,6 +39,20 @@ var setCmd = &cobra.Command{
 		if opts.clientOpts.KeyLifespan.Seconds() > 0 {
 			req.Lifespan = durationpb.New(opts.clientOpts.KeyLifespan)
 		}
+		if opts.clientOpts.KeyExpireAt != "" {
+			expireAt, err := time.Parse(
+				time.RFC3339,
+				opts.clientOpts.KeyExpireAt,
+			)
+			printError(err)
+			req.ExpireAt = timestamppb.New(expireAt)
+		}
+		req.Labels = opts.clientOpts.Labels
+		req.ClearLabels = opts.clientOpts.ClearLabels
+
+		req.Mode, req.Version, req.Hash, err = writeCondition(cmd)
+		printError(err)
+		req.FencingToken = fencingToken(cmd)
 
 		kv, err := opts.client.Set(ctx, req)
 		printError(err)
@@ -52,10 +68,58 @@ func init() {
 		0,
 		"Expire key in specified duration (e.g. 1h30m)",
 	)
+	setCmd.Flags().StringVar(
+		&cliOpts.clientOpts.KeyExpireAt,
+		"expire-at",
+		"",
+		"Expire key at the specified time (RFC3339, e.g. 2024-01-02T15:04:05Z)",
+	)
 	setCmd.Flags().DurationVar(
 		&cliOpts.clientOpts.LockTimeout,
 		"lock",
 		0,
 		"Lock key for specified duration (e.g. 1h30m)",
 	)
+	setCmd.Flags().StringToStringVar(
+		&cliOpts.clientOpts.Labels,
+		"label",
+		nil,
+		"Label to attach to the key (ex: --label env=prod). May be repeated.",
+	)
+	setCmd.Flags().BoolVar(
+		&cliOpts.clientOpts.ClearLabels,
+		"clear-labels",
+		false,
+		"Remove the key's existing labels, other than those set with --label",
+	)
+	setCmd.Flags().BoolVar(
+		&cliOpts.clientOpts.WriteOpts.CreateOnly,
+		"create-only",
+		false,
+		"Only set the key if it doesn't already exist",
+	)
+	setCmd.Flags().BoolVar(
+		&cliOpts.clientOpts.WriteOpts.UpdateOnly,
+		"update-only",
+		false,
+		"Only set the key if it already exists",
+	)
+	setCmd.Flags().Uint64Var(
+		&cliOpts.clientOpts.WriteOpts.IfVersion,
+		"if-version",
+		0,
+		"Only set the key if its current version matches",
+	)
+	setCmd.Flags().Uint64Var(
+		&cliOpts.clientOpts.WriteOpts.IfHash,
+		"if-hash",
+		0,
+		"Only set the key if its current hash matches",
+	)
+	setCmd.Flags().Uint64Var(
+		&cliOpts.clientOpts.FencingToken,
+		"fencing-token",
+		0,
+		"Fencing token from a lock on the key, rejected if a newer lock was acquired since",
+	)
 }
@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+	"strconv"
+)
+
+var saddCmd = &cobra.Command{
+	Use:   "sadd [key] [members...]",
+	Short: "Adds members to a set",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.SAdd(
+			ctx,
+			&pb.SetMembersRequest{Key: args[0], Members: args[1:]},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var sremCmd = &cobra.Command{
+	Use:   "srem [key] [members...]",
+	Short: "Removes members from a set",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.SRem(
+			ctx,
+			&pb.SetMembersRequest{Key: args[0], Members: args[1:]},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var smembersCmd = &cobra.Command{
+	Use:   "smembers [key]",
+	Short: "Gets the members of a set",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.SMembers(ctx, &pb.Key{Key: args[0]})
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var sismemberCmd = &cobra.Command{
+	Use:   "sismember [key] [member]",
+	Short: "Checks whether a value is a member of a set",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.SIsMember(
+			ctx,
+			&pb.SIsMemberRequest{Key: args[0], Member: args[1]},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var zaddCmd = &cobra.Command{
+	Use:          "zadd [key] [score] [member] [score member...]",
+	Short:        "Adds members to a sorted set, or updates their scores",
+	Args:         cobra.MinimumNArgs(3),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		pairs := args[1:]
+		if len(pairs)%2 != 0 {
+			return fmt.Errorf("expected pairs of scores and members")
+		}
+		members := make([]*pb.ZMember, 0, len(pairs)/2)
+		for i := 0; i < len(pairs); i += 2 {
+			score, err := strconv.ParseFloat(pairs[i], 64)
+			if err != nil {
+				return fmt.Errorf("invalid score '%s': %w", pairs[i], err)
+			}
+			members = append(
+				members,
+				&pb.ZMember{Member: pairs[i+1], Score: score},
+			)
+		}
+		opts := &cliOpts
+		rv, err := opts.client.ZAdd(
+			ctx,
+			&pb.ZAddRequest{Key: args[0], Members: members},
+		)
+		printError(err)
+		printResult(rv)
+		return nil
+	},
+}
+
+var zrangebyscoreCmd = &cobra.Command{
+	Use:          "zrangebyscore [flags] [key] [min] [max]",
+	Short:        "Gets the members of a sorted set with scores between min and max (inclusive)",
+	Long:         "Gets the members of a sorted set with scores between min and max (inclusive). Use -inf and +inf for unbounded ranges.",
+	Args:         cobra.ExactArgs(3),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		minScore, err := strconv.ParseFloat(args[1], 64)
+		if err != nil {
+			return fmt.Errorf("invalid min '%s': %w", args[1], err)
+		}
+		maxScore, err := strconv.ParseFloat(args[2], 64)
+		if err != nil {
+			return fmt.Errorf("invalid max '%s': %w", args[2], err)
+		}
+		opts := &cliOpts
+		rv, err := opts.client.ZRangeByScore(
+			ctx,
+			&pb.ZRangeByScoreRequest{
+				Key:   args[0],
+				Min:   minScore,
+				Max:   maxScore,
+				Limit: opts.clientOpts.ZRangeLimit,
+			},
+		)
+		printError(err)
+		printResult(rv)
+		return nil
+	},
+}
+
+var zremCmd = &cobra.Command{
+	Use:   "zrem [key] [members...]",
+	Short: "Removes members from a sorted set",
+	Args:  cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.ZRem(
+			ctx,
+			&pb.SetMembersRequest{Key: args[0], Members: args[1:]},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var zpopminCmd = &cobra.Command{
+	Use:          "zpopmin [key] [count]",
+	Short:        "Removes and returns the members of a sorted set with the lowest scores",
+	Args:         cobra.RangeArgs(1, 2),
+	SilenceUsage: true,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+		req := &pb.ZPopMinRequest{Key: args[0]}
+		if len(args) == 2 {
+			count, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid count '%s': %w", args[1], err)
+			}
+			req.Count = count
+		}
+		opts := &cliOpts
+		rv, err := opts.client.ZPopMin(ctx, req)
+		printError(err)
+		printResult(rv)
+		return nil
+	},
+}
+
+func init() {
+	// Stop parsing flags after the key, so negative scores aren't
+	// mistaken for flags
+	zaddCmd.Flags().SetInterspersed(false)
+	zrangebyscoreCmd.Flags().SetInterspersed(false)
+	zrangebyscoreCmd.Flags().Uint64Var(
+		&cliOpts.clientOpts.ZRangeLimit,
+		"limit",
+		0,
+		"Maximum number of members to return",
+	)
+	clientCmd.AddCommand(
+		saddCmd,
+		sremCmd,
+		smembersCmd,
+		sismemberCmd,
+		zaddCmd,
+		zrangebyscoreCmd,
+		zremCmd,
+		zpopminCmd,
+	)
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/spf13/cobra"
+)
+
+var publishCmd = &cobra.Command{
+	Use:   "publish [topic] [payload]",
+	Short: "Publishes a message to the subscribers of a topic",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		rv, err := opts.client.Publish(
+			ctx,
+			&pb.PublishRequest{Topic: args[0], Payload: []byte(args[1])},
+		)
+		printError(err)
+		printResult(rv)
+	},
+}
+
+var subscribeCmd = &cobra.Command{
+	Use:   "subscribe [patterns...]",
+	Short: "Streams messages published to topics matching any of the given glob patterns",
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		opts := &cliOpts
+		stream, e := opts.client.SubscribeTopic(
+			ctx,
+			&pb.SubscribeTopicRequest{Patterns: args},
+		)
+		printError(e)
+
+		for {
+			if ctx.Err() != nil {
+				break
+			}
+			msg, err := stream.Recv()
+			printError(err)
+			if msg != nil {
+				fmt.Printf("%s\n", msg)
+			}
+		}
+	},
+}
+
+func init() {
+	clientCmd.AddCommand(publishCmd, subscribeCmd)
+}
@@ -14,7 +14,10 @@ var watchCmd = &cobra.Command{
 		opts := &cliOpts
 		client := opts.client
 
-		stream, e := client.WatchStream(ctx, &pb.WatchRequest{})
+		stream, e := client.WatchStream(
+			ctx,
+			&pb.WatchRequest{Selector: opts.clientOpts.WatchSelector},
+		)
 		printError(e)
 
 		for {
@@ -32,4 +35,11 @@ var watchCmd = &cobra.Command{
 
 func init() {
 	clientCmd.AddCommand(watchCmd)
+	watchCmd.Flags().StringVarP(
+		&cliOpts.clientOpts.WatchSelector,
+		"selector",
+		"l",
+		"",
+		"Only watch keys with labels matching this selector (ex: env=prod)",
+	)
 }
@@ -893,6 +893,23 @@ func TestListKeysCmd(t *testing.T) {
 	keys = strings.Split(data, "\n")
 	assertEqual(t, len(keys), 2)
 	assertSliceContains(t, keys, "bar", "baz")
+
+	// Walk every page, one key at a time
+	failOnErr(t, listCmd.Flags().Set("pattern", ""))
+	failOnErr(t, listCmd.Flags().Set("limit", "1"))
+	failOnErr(t, listCmd.Flags().Set("all", "true"))
+	t.Cleanup(
+		func() {
+			failOnErr(t, listCmd.Flags().Set("limit", "0"))
+			failOnErr(t, listCmd.Flags().Set("all", "false"))
+		},
+	)
+	data = captureOutput(
+		t, func() {
+			failOnErr(t, listCmd.Execute())
+		},
+	)
+	assertEqual(t, data, "bar\nbaz\nfoo")
 }
 
 func TestSetReadonlyCmd(t *testing.T) {
@@ -992,6 +1009,37 @@ func TestLockCmdCreateIfMissing(t *testing.T) {
 
 }
 
+func TestLockCmdShared(t *testing.T) {
+	addr := socketAddr(t)
+	_ = newServer(t, nil, addr)
+	client := newClient(t, addr)
+	cctx := clientCtx(t)
+	_, err := client.Set(cctx, &pb.KeyValue{Key: "foo", Value: []byte("baz")})
+	failOnErr(t, err)
+
+	rootCmd.SetArgs([]string{"client", "lock", "foo", "10s", "--shared"})
+	t.Cleanup(
+		func() {
+			cliOpts.clientOpts.LockShared = false
+		},
+	)
+
+	f := func() {
+		failOnErr(t, lockCmd.Execute())
+	}
+	data := captureOutput(t, f)
+
+	var lockResponse pb.LockResponse
+	err = json.Unmarshal([]byte(data), &lockResponse)
+	failOnErr(t, err)
+	assertEqual(t, lockResponse.Success, true)
+
+	kvInfo, err := client.Inspect(cctx, &pb.InspectRequest{Key: "foo"})
+	fatalOnErr(t, err)
+	assertEqual(t, len(kvInfo.LockHolders), 1)
+	assertEqual(t, kvInfo.LockHolders[0].Mode, pb.LockMode_SHARED)
+}
+
 func TestUnlockCmd(t *testing.T) {
 	addr := socketAddr(t)
 	_ = newServer(t, nil, addr)
@@ -1236,3 +1284,186 @@ func fatalOnErr(t *testing.T, err error, msg ...string) {
 
 	t.Fatalf("expected no error, got: %s", err.Error())
 }
+
+func TestLoadCmd(t *testing.T) {
+	addr := socketAddr(t)
+	_ = newServer(t, nil, addr)
+	client := newClient(t, addr)
+	rootCmd.SetArgs(
+		[]string{
+			"client",
+			"load",
+			"--chunk-size",
+			"2",
+			"foo=bar",
+			"baz=qux",
+			"quux=corge",
+		},
+	)
+	failOnErr(t, bulkSetCmd.Execute())
+
+	expected := map[string]string{"foo": "bar", "baz": "qux", "quux": "corge"}
+	for k, v := range expected {
+		rv, err := client.Get(clientCtx(t), &pb.Key{Key: k})
+		fatalOnErr(t, err)
+		assertEqual(t, string(rv.Value), v)
+	}
+}
+
+func TestIncrementCmd(t *testing.T) {
+	addr := socketAddr(t)
+	_ = newServer(t, nil, addr)
+
+	rootCmd.SetArgs([]string{"client", "incr", "counter", "5"})
+	data := captureOutput(
+		t, func() {
+			failOnErr(t, incrementCmd.Execute())
+		},
+	)
+	assertEqual(t, strings.TrimSpace(data), "5")
+
+	rootCmd.SetArgs([]string{"client", "decr", "counter"})
+	data = captureOutput(
+		t, func() {
+			failOnErr(t, decrementCmd.Execute())
+		},
+	)
+	assertEqual(t, strings.TrimSpace(data), "4")
+}
+
+func TestRenameCmd(t *testing.T) {
+	addr := socketAddr(t)
+	_ = newServer(t, nil, addr)
+
+	rootCmd.SetArgs([]string{"client", "set", "foo", "bar"})
+	_ = captureOutput(
+		t, func() {
+			failOnErr(t, setCmd.Execute())
+		},
+	)
+
+	rootCmd.SetArgs([]string{"client", "copy", "foo", "baz"})
+	data := captureOutput(
+		t, func() {
+			failOnErr(t, copyCmd.Execute())
+		},
+	)
+	assertEqual(t, data, `{"success":true,"version":1}`)
+
+	rootCmd.SetArgs([]string{"client", "rename", "foo", "qux"})
+	data = captureOutput(
+		t, func() {
+			failOnErr(t, renameCmd.Execute())
+		},
+	)
+	assertEqual(t, data, `{"success":true,"version":1}`)
+
+	rootCmd.SetArgs([]string{"client", "get", "qux"})
+	data = captureOutput(
+		t, func() {
+			failOnErr(t, getCmd.Execute())
+		},
+	)
+	assertEqual(t, data, "bar")
+}
+
+func TestLabelsCmd(t *testing.T) {
+	addr := socketAddr(t)
+	_ = newServer(t, nil, addr)
+	client := newClient(t, addr)
+
+	t.Cleanup(
+		func() {
+			cliOpts.clientOpts.Labels = nil
+			failOnErr(t, listCmd.Flags().Set("selector", ""))
+		},
+	)
+
+	rootCmd.SetArgs(
+		[]string{"client", "set", "--label", "env=prod", "foo", "bar"},
+	)
+	_ = captureOutput(
+		t, func() {
+			failOnErr(t, setCmd.Execute())
+		},
+	)
+
+	cctx := clientCtx(t)
+	_, err := client.Set(
+		cctx,
+		&pb.KeyValue{
+			Key:    "baz",
+			Value:  []byte("baz"),
+			Labels: map[string]string{"env": "dev"},
+		},
+	)
+	failOnErr(t, err)
+
+	rootCmd.SetArgs([]string{"client", "list", "--selector", "env=prod"})
+	data := captureOutput(
+		t, func() {
+			failOnErr(t, listCmd.Execute())
+		},
+	)
+	assertEqual(t, data, "foo")
+}
+
+func TestHistoryCmd(t *testing.T) {
+	addr := socketAddr(t)
+	_ = newServer(t, nil, addr)
+	client := newClient(t, addr)
+	cctx := clientCtx(t)
+
+	original := "a\nb\nc\n"
+	for _, value := range []string{original, "a\nB\nc\n"} {
+		_, err := client.Set(
+			cctx,
+			&pb.KeyValue{Key: "foo", Value: []byte(value)},
+		)
+		fatalOnErr(t, err)
+	}
+
+	rootCmd.SetArgs([]string{"client", "history", "diff", "foo", "1", "2"})
+	data := captureOutput(
+		t, func() {
+			failOnErr(t, diffRevisionsCmd.Execute())
+		},
+	)
+	expected := "--- foo@1\n+++ foo@2\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c"
+	assertEqual(t, data, expected)
+
+	rootCmd.SetArgs([]string{"client", "history", "rollback", "foo", "1"})
+	_ = captureOutput(
+		t, func() {
+			failOnErr(t, rollbackCmd.Execute())
+		},
+	)
+
+	rv, err := client.Get(cctx, &pb.Key{Key: "foo"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(rv.Value), original)
+
+	rootCmd.SetArgs([]string{"client", "history", "list", "foo"})
+	data = captureOutput(
+		t, func() {
+			failOnErr(t, listRevisionsCmd.Execute())
+		},
+	)
+	lines := strings.Split(data, "\n")
+	assertEqual(t, strings.Split(lines[len(lines)-1], "\t")[0], "3")
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	from := "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n"
+	to := "one\n2\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\neleven"
+	expected := "--- a\n+++ b\n" +
+		"@@ -1,5 +1,5 @@\n one\n-two\n+2\n three\n four\n five\n" +
+		"@@ -8,3 +8,4 @@\n eight\n nine\n ten\n+eleven\n\\ No newline at end of file\n"
+	assertEqual(t, unifiedDiff("a", "b", from, to), expected)
+	assertEqual(t, unifiedDiff("a", "b", from, from), "")
+	assertEqual(
+		t,
+		unifiedDiff("a", "b", "", "new\n"),
+		"--- a\n+++ b\n@@ -0,0 +1 @@\n+new\n",
+	)
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around changes
+// in a unified diff
+const diffContext = 3
+
+type diffOp struct {
+	// kind is ' ' for an unchanged line, '-' for a removed line and '+'
+	// for an added line
+	kind byte
+	line string
+}
+
+// diffLines returns the shortest edit script turning a into b, using
+// Myers' algorithm
+func diffLines(a []string, b []string) []diffOp {
+	n, m := len(a), len(b)
+	maxEdits := n + m
+	if maxEdits == 0 {
+		return nil
+	}
+
+	// v holds the furthest x reached on each diagonal k (x - y), offset
+	// so k can be negative. trace holds v as it was before each round.
+	offset := maxEdits + 1
+	v := make([]int, 2*maxEdits+3)
+	var trace [][]int
+
+search:
+	for d := 0; d <= maxEdits; d++ {
+		trace = append(trace, append([]int(nil), v...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	// Walk back from the end, through each round, to recover the edits
+	ops := make([]diffOp, 0, maxEdits)
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		tv := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && tv[offset+k-1] < tv[offset+k+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := tv[offset+prevK]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			x--
+			y--
+			ops = append(ops, diffOp{kind: ' ', line: a[x]})
+		}
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, diffOp{kind: '+', line: b[prevY]})
+			} else {
+				ops = append(ops, diffOp{kind: '-', line: a[prevX]})
+			}
+		}
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// splitLines splits text into lines, keeping their line endings
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.SplitAfter(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// unifiedDiff returns a unified diff of two texts, labeled fromName and
+// toName, or an empty string if they're the same
+func unifiedDiff(fromName string, toName string, from string, to string) string {
+	a := splitLines(from)
+	b := splitLines(to)
+	// Only the last line of each text may lack a line ending
+	aEnd := strings.HasSuffix(from, "\n")
+	bEnd := strings.HasSuffix(to, "\n")
+	if len(a) > 0 && aEnd {
+		a[len(a)-1] += "\n"
+	}
+	if len(b) > 0 && bEnd {
+		b[len(b)-1] += "\n"
+	}
+
+	ops := diffLines(a, b)
+
+	// aPos and bPos are the number of lines of a and b before each op
+	aPos := make([]int, len(ops)+1)
+	bPos := make([]int, len(ops)+1)
+	for i, op := range ops {
+		aPos[i+1], bPos[i+1] = aPos[i], bPos[i]
+		if op.kind != '+' {
+			aPos[i+1]++
+		}
+		if op.kind != '-' {
+			bPos[i+1]++
+		}
+	}
+
+	sb := &strings.Builder{}
+	i := 0
+	for i < len(ops) {
+		for i < len(ops) && ops[i].kind == ' ' {
+			i++
+		}
+		if i == len(ops) {
+			break
+		}
+
+		// Changes separated by few enough unchanged lines share a hunk
+		start := max(i-diffContext, 0)
+		end := i
+		for {
+			for end < len(ops) && ops[end].kind != ' ' {
+				end++
+			}
+			next := end
+			for next < len(ops) && ops[next].kind == ' ' {
+				next++
+			}
+			if next == len(ops) || next-end > 2*diffContext {
+				break
+			}
+			end = next
+		}
+		end = min(end+diffContext, len(ops))
+
+		if sb.Len() == 0 {
+			fmt.Fprintf(sb, "--- %s\n+++ %s\n", fromName, toName)
+		}
+		fmt.Fprintf(
+			sb,
+			"@@ -%s +%s @@\n",
+			hunkRange(aPos[start], aPos[end]-aPos[start]),
+			hunkRange(bPos[start], bPos[end]-bPos[start]),
+		)
+		for _, op := range ops[start:end] {
+			sb.WriteByte(op.kind)
+			sb.WriteString(op.line)
+			if !strings.HasSuffix(op.line, "\n") {
+				sb.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		i = end
+	}
+	return sb.String()
+}
+
+// hunkRange formats the start line and line count of one side of a
+// hunk, where start is the number of lines before the hunk
+func hunkRange(start int, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, count)
+	}
+}
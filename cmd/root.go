@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	pb "github.com/arcward/keyquarry/api"
 	"github.com/arcward/keyquarry/client"
 	"github.com/arcward/keyquarry/server"
 	"github.com/spf13/cobra"
@@ -52,6 +53,10 @@ type clientOptions struct {
 	// generated.
 	ClientID string
 
+	// Namespace is the namespace to scope keys to. If empty, the
+	// default namespace is used.
+	Namespace string
+
 	// Verbose enables debug logging
 	Verbose bool
 
@@ -83,17 +88,73 @@ type clientOptions struct {
 	// KeyLifespan is for commands that allow specifying a key lifespan
 	KeyLifespan time.Duration
 
+	// KeyExpireAt is for commands that allow specifying an absolute
+	// expiration time for a key, in RFC3339 format
+	KeyExpireAt string
+
+	// Labels is for commands that allow specifying key labels
+	Labels map[string]string
+
+	// ClearLabels removes a key's existing labels with the set command
+	ClearLabels bool
+
+	// WatchSelector is a label selector for the watch command
+	WatchSelector string
+
 	// LockTimeout specifies a lock duration for commands that lock a key
 	LockTimeout time.Duration
 
 	// LockCreateIfMissing sets `create_if_missing` for the lock command
 	LockCreateIfMissing bool
 
+	// LockShared requests a shared lock, rather than an exclusive lock,
+	// with the lock command
+	LockShared bool
+
+	// LockWait is how long the lock command waits for another client
+	// to release its lock
+	LockWait time.Duration
+
+	// ListBlock is how long the lpop/rpop commands wait for a value to
+	// be pushed, if the list is empty
+	ListBlock time.Duration
+
+	// ZRangeLimit is the maximum number of members returned by the
+	// zrangebyscore command
+	ZRangeLimit uint64
+
+	// ElectionOpts holds options for the election commands
+	ElectionOpts struct {
+		Lease time.Duration
+		Wait  time.Duration
+	}
+
 	// ListKeyOpts holds options for list-keys
 	ListKeyOpts struct {
 		Pattern         string
+		Prefix          string
+		Limit           uint64
+		IncludeReserved bool
+		StartAfter      string
+		PageToken       string
+		Selector        string
+		All             bool
+	}
+
+	// ScanOpts holds options for scan
+	ScanOpts struct {
+		Prefix          string
+		Start           string
+		End             string
 		Limit           uint64
 		IncludeReserved bool
+		IncludeLocked   bool
+	}
+
+	// CopyOpts holds options for rename and copy
+	CopyOpts struct {
+		Overwrite      bool
+		IncludeHistory bool
 	}
 
 	// InspectIncludeValue sets `include_value` for the inspect command
@@ -107,6 +168,21 @@ type clientOptions struct {
 
 	// GetKeyVersion specifies a revision to retrieve for the Get command
 	GetKeyVersion int64
+
+	// LoadChunkSize is the number of keys sent per request by load
+	LoadChunkSize int
+
+	// WriteOpts holds conditional write options for set and delete
+	WriteOpts struct {
+		CreateOnly bool
+		UpdateOnly bool
+		IfVersion  uint64
+		IfHash     uint64
+	}
+
+	// FencingToken is the fencing token (from a lock) to provide with
+	// set, delete and pop
+	FencingToken uint64
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -180,6 +256,7 @@ func initConfig() {
 	viper.SetDefault("max_value_size", server.DefaultMaxValueSize)
 	viper.SetDefault("max_key_length", server.DefaultMaxKeyLength)
 	viper.SetDefault("max_keys", 0)
+	viper.SetDefault("compression_threshold", 0)
 
 	cobra.CheckErr(
 		viper.BindPFlag(
@@ -246,6 +323,11 @@ func initConfig() {
 	viper.SetDefault("snapshot.interval", "0")
 	viper.SetDefault("snapshot.database", "")
 
+	viper.SetDefault("encryption.key_id", server.DefaultEncryptionKeyID)
+	viper.SetDefault("encryption.key", "")
+	viper.SetDefault("encryption.key_file", "")
+	viper.SetDefault("encryption.values", false)
+
 	// service name used in traces
 	viper.SetDefault("service_name", "keyquarry")
 
@@ -379,6 +461,52 @@ func printResult(m proto.Message) {
 	printError(err)
 }
 
+// writeCondition returns the pb.WriteMode, expected version and expected
+// hash for the conditional write flags set on the given command
+func writeCondition(cmd *cobra.Command) (
+	mode pb.WriteMode,
+	version *uint64,
+	hash *uint64,
+	err error,
+) {
+	writeOpts := cliOpts.clientOpts.WriteOpts
+	if cmd.Flags().Changed("if-version") {
+		version = &writeOpts.IfVersion
+	}
+	if cmd.Flags().Changed("if-hash") {
+		hash = &writeOpts.IfHash
+	}
+
+	switch {
+	case writeOpts.CreateOnly && writeOpts.UpdateOnly:
+		return mode, nil, nil, errors.New(
+			"--create-only and --update-only are mutually exclusive",
+		)
+	case writeOpts.CreateOnly:
+		if version != nil || hash != nil {
+			return mode, nil, nil, errors.New(
+				"--create-only can't be used with --if-version or --if-hash",
+			)
+		}
+		mode = pb.WriteMode_CREATE_ONLY
+	case version != nil || hash != nil:
+		mode = pb.WriteMode_IF_VERSION_MATCHES
+	case writeOpts.UpdateOnly:
+		mode = pb.WriteMode_UPDATE_ONLY
+	}
+	return mode, version, hash, nil
+}
+
+// fencingToken returns the value of the --fencing-token flag for the
+// given command, or nil if it wasn't set
+func fencingToken(cmd *cobra.Command) *uint64 {
+	if !cmd.Flags().Changed("fencing-token") {
+		return nil
+	}
+	token := cliOpts.clientOpts.FencingToken
+	return &token
+}
+
 // printError is a helper function which, if the given error is not nil,
 // prints the error to stderr and exits with status code 1
 func printError(err error) {
@@ -60,7 +60,99 @@ func (a *Admin) Prune(
 	if !ok || err != nil {
 		return nil, err
 	}
-	a.logger.Log(ctx, LevelNotice, "prune requested")
-	rv := a.srv.pruner.Prune(ctx, req.PruneTo, req.IgnoreKeys...)
+	namespace := a.srv.Namespace(ctx)
+	a.logger.Log(
+		ctx,
+		LevelNotice,
+		"prune requested",
+		slog.String("namespace", namespace),
+	)
+
+	// Without a namespace, keys are pruned across all namespaces
+	if namespace == "" {
+		rv := a.srv.pruner.Prune(ctx, req.PruneTo, req.IgnoreKeys...)
+		return &pb.PruneResponse{Pruned: uint64(len(rv))}, nil
+	}
+
+	ignoreKeys := make([]string, 0, len(req.IgnoreKeys))
+	for _, k := range req.IgnoreKeys {
+		ignoreKeys = append(ignoreKeys, namespacedKey(namespace, k))
+	}
+	rv := a.srv.pruner.PruneNamespace(ctx, namespace, req.PruneTo, ignoreKeys...)
 	return &pb.PruneResponse{Pruned: uint64(len(rv))}, nil
 }
+
+// SetSchema binds a JSON Schema to a key prefix in the request's
+// namespace, replacing any schema already bound to it. Existing values
+// aren't validated - only values set afterward.
+func (a *Admin) SetSchema(
+	ctx context.Context,
+	req *pb.SetSchemaRequest,
+) (*pb.SetSchemaResponse, error) {
+	ok, err := a.validatePrivilegedClientID(ctx)
+	if !ok || err != nil {
+		return nil, err
+	}
+	namespace := a.srv.Namespace(ctx)
+	a.logger.Log(
+		ctx,
+		LevelNotice,
+		"schema update requested",
+		slog.String("namespace", namespace),
+		slog.String("prefix", req.Prefix),
+	)
+
+	ks, err := newKeySchema(namespace, req.Prefix, req.Schema)
+	if err != nil {
+		return nil, err
+	}
+	replaced := a.srv.setSchema(ks)
+	return &pb.SetSchemaResponse{Replaced: replaced}, nil
+}
+
+// DeleteSchema removes the JSON Schema bound to a key prefix in the
+// request's namespace
+func (a *Admin) DeleteSchema(
+	ctx context.Context,
+	req *pb.DeleteSchemaRequest,
+) (*pb.DeleteSchemaResponse, error) {
+	ok, err := a.validatePrivilegedClientID(ctx)
+	if !ok || err != nil {
+		return nil, err
+	}
+	namespace := a.srv.Namespace(ctx)
+	a.logger.Log(
+		ctx,
+		LevelNotice,
+		"schema deletion requested",
+		slog.String("namespace", namespace),
+		slog.String("prefix", req.Prefix),
+	)
+
+	deleted := a.srv.deleteSchema(namespace, req.Prefix)
+	return &pb.DeleteSchemaResponse{Deleted: deleted}, nil
+}
+
+// ListSchemas returns the JSON Schemas bound to key prefixes in the
+// request's namespace, sorted by prefix
+func (a *Admin) ListSchemas(
+	ctx context.Context,
+	_ *pb.ListSchemasRequest,
+) (*pb.ListSchemasResponse, error) {
+	ok, err := a.validatePrivilegedClientID(ctx)
+	if !ok || err != nil {
+		return nil, err
+	}
+
+	schemas := a.srv.listSchemas(a.srv.Namespace(ctx))
+	rv := &pb.ListSchemasResponse{
+		Schemas: make([]*pb.KeySchema, 0, len(schemas)),
+	}
+	for _, ks := range schemas {
+		rv.Schemas = append(
+			rv.Schemas,
+			&pb.KeySchema{Prefix: ks.Prefix, Schema: ks.Source},
+		)
+	}
+	return rv, nil
+}
@@ -0,0 +1,193 @@
+package server
+
+import (
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"time"
+)
+
+// BatchGet returns the values of the given keys, with a result for each
+// key. Keys which don't exist, are locked by another client, or don't
+// have a STRING value will have an error set on their result, as with Get.
+func (s *Server) BatchGet(ctx context.Context, in *pb.BatchGetRequest) (
+	*pb.BatchGetResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("keys", len(in.Keys)))
+
+	logger := s.requestLogger(ctx)
+	logger.Info("getting keys", slog.Int("count", len(in.Keys)))
+	clientID := s.ClientID(ctx)
+
+	resp := &pb.BatchGetResponse{
+		Results: make([]*pb.BatchGetResult, 0, len(in.Keys)),
+	}
+
+	namespace := s.Namespace(ctx)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	s.lockMu.RLock()
+	defer s.lockMu.RUnlock()
+
+	now := time.Now()
+	for _, name := range in.Keys {
+		result := &pb.BatchGetResult{Key: name}
+		resp.Results = append(resp.Results, result)
+
+		key := namespacedKey(namespace, name)
+
+		kvInfo := s.getKey(key)
+		if kvInfo == nil {
+			result.Error = newItemError(ErrKeyNotFound)
+			continue
+		}
+
+		keyLock, locked := s.locks[key]
+		if locked && clientID != keyLock.ClientID {
+			logger.Info(
+				"cannot get key locked by another client", kvLogKey, kvInfo,
+			)
+			result.Error = newItemError(ErrLocked)
+			continue
+		}
+
+		kvInfo.mu.RLock()
+		if kvInfo.Type != pb.ValueType_STRING {
+			kvInfo.mu.RUnlock()
+			result.Error = newItemError(ErrWrongType)
+			continue
+		}
+		value, err := kvInfo.rawValue(s.keys)
+		kvInfo.mu.RUnlock()
+		if err != nil {
+			result.Error = newItemError(err)
+			continue
+		}
+		result.Value = value
+		s.emit(key, Accessed, clientID, &now)
+	}
+	return resp, nil
+}
+
+// BatchSet sets the given keys, with a result for each item. Each item
+// is validated and applied as with Set, and independently of the other
+// items, so an error for one item (which will be set on its result)
+// doesn't prevent the rest from being set.
+func (s *Server) BatchSet(ctx context.Context, in *pb.BatchSetRequest) (
+	*pb.BatchSetResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("keys", len(in.Items)))
+
+	logger := s.requestLogger(ctx)
+	logger.Info("setting keys", slog.Int("count", len(in.Items)))
+	clientID := s.ClientID(ctx)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+	namespace := s.Namespace(ctx)
+	cfg = cfg.forNamespace(namespace)
+
+	resp := &pb.BatchSetResponse{
+		Results: make([]*pb.BatchSetResult, 0, len(in.Items)),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, item := range in.Items {
+		result := &pb.BatchSetResult{Key: item.Key}
+		resp.Results = append(resp.Results, result)
+
+		lockDuration, expireAfter, err := validateSetRequest(cfg, item)
+		if err != nil {
+			result.Error = newItemError(err)
+			continue
+		}
+		item.Key = namespacedKey(namespace, item.Key)
+
+		result.Result, err = s.setKey(
+			logger,
+			cfg,
+			clientID,
+			item,
+			lockDuration,
+			expireAfter,
+		)
+		if err != nil {
+			result.Error = newItemError(err)
+		}
+	}
+	return resp, nil
+}
+
+// BatchDelete deletes the given keys, with a result for each item. As
+// with BatchSet, items are applied independently, and an error for one
+// item will be set on its result.
+func (s *Server) BatchDelete(
+	ctx context.Context,
+	in *pb.BatchDeleteRequest,
+) (*pb.BatchDeleteResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int("keys", len(in.Items)))
+
+	logger := s.requestLogger(ctx)
+	logger.Info("deleting keys", slog.Int("count", len(in.Items)))
+	clientID := s.ClientID(ctx)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+	namespace := s.Namespace(ctx)
+	cfg = cfg.forNamespace(namespace)
+
+	resp := &pb.BatchDeleteResponse{
+		Results: make([]*pb.BatchDeleteResult, 0, len(in.Items)),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, item := range in.Items {
+		result := &pb.BatchDeleteResult{Key: item.Key}
+		resp.Results = append(resp.Results, result)
+
+		if err := validateDeleteRequest(item); err != nil {
+			result.Error = newItemError(err)
+			continue
+		}
+		item.Key = namespacedKey(namespace, item.Key)
+
+		rv, err := s.deleteKeyValue(logger, cfg, clientID, item)
+		result.Result = rv
+		if err != nil {
+			result.Error = newItemError(err)
+		}
+	}
+	return resp, nil
+}
+
+// newItemError converts the given error to a [pb.ItemError], using
+// its gRPC status
+func newItemError(err error) *pb.ItemError {
+	st := status.Convert(err)
+	return &pb.ItemError{
+		Code:    uint32(st.Code()),
+		Message: st.Message(),
+	}
+}
@@ -0,0 +1,119 @@
+package server
+
+import (
+	"bytes"
+	"compress/gzip"
+	pb "github.com/arcward/keyquarry/api"
+	"google.golang.org/grpc/codes"
+	"io"
+	"sync"
+)
+
+var ErrDecompressValue = KQError{
+	Message: "unable to decompress stored value",
+	Code:    codes.Internal,
+}
+
+// gzipWriters pools gzip.Writer values, which allocate a fair amount
+// of memory when created
+var gzipWriters = sync.Pool{
+	New: func() any {
+		return gzip.NewWriter(io.Discard)
+	},
+}
+
+// compressValue returns the value compressed with gzip, and true, if
+// threshold is greater than 0, the value is larger than threshold, and
+// compressing it saves space. Otherwise, the value is returned as-is.
+func compressValue(value []byte, threshold uint64) ([]byte, bool) {
+	if threshold == 0 || uint64(len(value)) <= threshold {
+		return value, false
+	}
+
+	buf := &bytes.Buffer{}
+	zw := gzipWriters.Get().(*gzip.Writer)
+	defer func() {
+		zw.Reset(io.Discard)
+		gzipWriters.Put(zw)
+	}()
+	zw.Reset(buf)
+	if _, err := zw.Write(value); err != nil {
+		return value, false
+	}
+	if err := zw.Close(); err != nil {
+		return value, false
+	}
+	if buf.Len() >= len(value) {
+		return value, false
+	}
+	return buf.Bytes(), true
+}
+
+// decompressValue returns the original value for one compressed with
+// compressValue
+func decompressValue(data []byte) ([]byte, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	return io.ReadAll(zr)
+}
+
+// setValue sets the key's (STRING) value, compressing it if it's larger
+// than threshold (see compressValue), and encrypting it if keys isn't
+// nil. keyValue.mu must be held by the caller.
+func (kv *keyValue) setValue(value []byte, threshold uint64, keys *keyring) {
+	kv.Value, kv.Compressed = compressValue(value, threshold)
+	kv.Encrypted = keys != nil
+	if kv.Encrypted {
+		kv.Value = keys.encryptValue(kv.Value)
+	}
+}
+
+// rawValue returns the key's (STRING) value, decrypting and
+// decompressing it as needed. keyValue.mu must be held by the caller.
+func (kv *keyValue) rawValue(keys *keyring) ([]byte, error) {
+	return decodeValue(kv.Value, kv.Compressed, kv.Encrypted, keys)
+}
+
+// storedSize returns the number of bytes used to store the key's value,
+// which differs from Size if it's compressed or encrypted. Values of
+// other types are counted at their original size. keyValue.mu must be
+// held by the caller.
+func (kv *keyValue) storedSize() uint64 {
+	if kv.Type == pb.ValueType_STRING && (kv.Compressed || kv.Encrypted) {
+		return uint64(len(kv.Value))
+	}
+	return kv.Size
+}
+
+// rawValue returns the revision's value, decrypting and decompressing
+// it as needed
+func (kvs *keyValueSnapshot) rawValue(keys *keyring) ([]byte, error) {
+	return decodeValue(kvs.Value, kvs.Compressed, kvs.Encrypted, keys)
+}
+
+// decodeValue reverses setValue for a stored value
+func decodeValue(
+	data []byte,
+	compressed bool,
+	encrypted bool,
+	keys *keyring,
+) ([]byte, error) {
+	if encrypted {
+		decrypted, err := keys.decryptValue(data)
+		if err != nil {
+			return nil, ErrDecryptValue
+		}
+		data = decrypted
+	}
+	if !compressed {
+		return data, nil
+	}
+	value, err := decompressValue(data)
+	if err != nil {
+		return nil, ErrDecompressValue
+	}
+	return value, nil
+}
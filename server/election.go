@@ -0,0 +1,334 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
+	"time"
+)
+
+// electionKeyPrefix is the prefix for the internal keys backing elections.
+// The leader of an election holds the exclusive lock on its key, and the
+// key's value is the value published by the leader.
+const electionKeyPrefix = ReservedKeyPrefix + "/election/"
+
+var (
+	ErrEmptyElectionName = KQError{
+		Message: "election name is required",
+		Code:    codes.InvalidArgument,
+	}
+	ErrNoLeader = KQError{
+		Message: "election has no leader",
+		Code:    codes.NotFound,
+	}
+)
+
+// electionKey returns the key for the named election
+func electionKey(name string) string {
+	return electionKeyPrefix + name
+}
+
+// Campaign waits until the client holds the lock on the named election's
+// key, then sets the key's value to the value being published. If the
+// client is already the leader, its lock (and value) is renewed
+// immediately. Candidates are elected in the order they started waiting,
+// as with Lock.
+func (s *Server) Campaign(
+	ctx context.Context,
+	in *pb.CampaignRequest,
+) (*pb.CampaignResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("election", in.Name))
+
+	logger := s.requestLogger(ctx).With("election", in.Name)
+	clientID := s.ClientID(ctx)
+	logger.Info("campaign request")
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+	if in.Name == "" {
+		return nil, ErrEmptyElectionName
+	}
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+
+	// The election name is validated as if it were the key, as
+	// validation would reject the reserved prefix of the actual key
+	lockReq := &pb.LockRequest{
+		Key:             in.Name,
+		Duration:        in.Lease,
+		CreateIfMissing: true,
+	}
+	lockDuration, err := validateLockRequest(cfg, lockReq)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err = validateSetRequest(
+		cfg,
+		&pb.KeyValue{Key: in.Name, Value: in.Value},
+	); err != nil {
+		return nil, err
+	}
+	var waitTimeout time.Duration
+	if in.WaitTimeout != nil {
+		waitTimeout = in.WaitTimeout.AsDuration()
+		if waitTimeout <= 0 {
+			return nil, ErrInvalidWaitTimeout
+		}
+	}
+	key := s.namespacedKey(ctx, electionKey(in.Name))
+	lockReq.Key = key
+
+	var resp *pb.CampaignResponse
+	publish := func() error {
+		var e error
+		resp, e = s.publishLeaderValue(logger, cfg, clientID, key, in.Value)
+		return e
+	}
+
+	s.mu.Lock()
+	_, err = s.lockKey(logger, cfg, clientID, lockReq, lockDuration)
+	switch {
+	case err == nil:
+		err = publish()
+		s.mu.Unlock()
+	case status.Code(err) == codes.PermissionDenied:
+		s.lockMu.Lock()
+		w := s.enqueueLockWaiter(key, clientID)
+		s.lockMu.Unlock()
+		s.mu.Unlock()
+		_, err = s.waitForLock(
+			ctx,
+			logger,
+			cfg,
+			clientID,
+			lockReq,
+			lockDuration,
+			waitTimeout,
+			w,
+			publish,
+		)
+	default:
+		s.mu.Unlock()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("leader elected", "fencing_token", resp.FencingToken)
+	return resp, nil
+}
+
+// publishLeaderValue sets the value of the election key, after the
+// client has been granted its lock. Server.mu must be held by the caller.
+func (s *Server) publishLeaderValue(
+	logger *slog.Logger,
+	cfg Config,
+	clientID string,
+	key string,
+	value []byte,
+) (*pb.CampaignResponse, error) {
+	_, err := s.setKey(
+		logger,
+		cfg,
+		clientID,
+		&pb.KeyValue{Key: key, Value: value},
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	s.lockMu.RLock()
+	defer s.lockMu.RUnlock()
+	keyLock := s.locks[key]
+	return &pb.CampaignResponse{
+		Leader:       true,
+		FencingToken: keyLock.FencingToken,
+		Expires:      timestamppb.New(keyLock.expires),
+	}, nil
+}
+
+// Resign releases the client's lock on the named election's key, if it's
+// the leader, allowing the next candidate to be elected
+func (s *Server) Resign(
+	ctx context.Context,
+	in *pb.ResignRequest,
+) (*pb.ResignResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("election", in.Name))
+
+	logger := s.requestLogger(ctx).With("election", in.Name)
+	clientID := s.ClientID(ctx)
+	logger.Info("resign request")
+
+	s.cfgMu.RLock()
+	readonly := s.cfg.Readonly
+	s.cfgMu.RUnlock()
+	if readonly {
+		return nil, ErrReadOnlyServer
+	}
+	if in.Name == "" {
+		return nil, ErrEmptyElectionName
+	}
+	key := s.namespacedKey(ctx, electionKey(in.Name))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lockMu.Lock()
+	defer s.lockMu.Unlock()
+
+	keyLock, ok := s.locks[key]
+	if !ok || keyLock.ClientID != clientID {
+		logger.Info("not the current leader")
+		return &pb.ResignResponse{Resigned: false}, nil
+	}
+	s.releaseLock(keyLock, clientID)
+	logger.Info("resigned leadership")
+	return &pb.ResignResponse{Resigned: true}, nil
+}
+
+// Leader returns the current leader of the named election, or
+// ErrNoLeader if there isn't one
+func (s *Server) Leader(
+	ctx context.Context,
+	in *pb.LeaderRequest,
+) (*pb.LeaderResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("election", in.Name))
+
+	logger := s.requestLogger(ctx)
+	logger.Info("leader request", "election", in.Name)
+	if in.Name == "" {
+		return nil, ErrEmptyElectionName
+	}
+	key := s.namespacedKey(ctx, electionKey(in.Name))
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	resp := s.leader(in.Name, key)
+	if resp.ClientId == "" {
+		return nil, ErrNoLeader
+	}
+	return resp, nil
+}
+
+// ObserveLeader sends the current leader of the named election, and then
+// the new leader each time the leader (or its value) changes. When the
+// election has no leader, a response with an empty client ID is sent.
+func (s *Server) ObserveLeader(
+	in *pb.LeaderRequest,
+	stream pb.KeyQuarry_ObserveLeaderServer,
+) error {
+	ctx := stream.Context()
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("election", in.Name))
+
+	logger := s.requestLogger(ctx).With("election", in.Name)
+	clientID := s.ClientID(ctx)
+	logger.Info("observing leader")
+	if in.Name == "" {
+		return ErrEmptyElectionName
+	}
+	key := s.namespacedKey(ctx, electionKey(in.Name))
+
+	// Subscribe before sending the current leader, so a change between
+	// the two isn't missed
+	streamClientID := fmt.Sprintf(
+		"%s/ObserveLeader/%s",
+		clientID,
+		uuid.NewString(),
+	)
+	sctx, cancel := context.WithCancel(ctx)
+	defer func() {
+		cancel()
+		if unsubErr := s.Unsubscribe(streamClientID); unsubErr != nil {
+			s.logger.Warn("unsubscribe failed", "error", unsubErr)
+		}
+	}()
+	events, err := s.Subscribe(
+		sctx,
+		streamClientID,
+		[]string{key},
+		[]KeyEvent{Locked, Unlocked, Updated, Deleted, Expired, Expunged},
+	)
+	if err != nil {
+		return KQError{Message: err.Error(), Code: codes.Internal}
+	}
+
+	var last *pb.LeaderResponse
+	sendLeader := func() error {
+		s.mu.RLock()
+		current := s.leader(in.Name, key)
+		s.mu.RUnlock()
+
+		// Renewing the lease emits Locked, but isn't a change in leadership
+		if last != nil &&
+			last.ClientId == current.ClientId &&
+			last.FencingToken == current.FencingToken &&
+			bytes.Equal(last.Value, current.Value) {
+			return nil
+		}
+		last = current
+		logger.Debug("sending leader", "leader", current.ClientId)
+		return stream.Send(current)
+	}
+
+	if err = sendLeader(); err != nil {
+		return err
+	}
+	for range events {
+		if sctx.Err() != nil {
+			break
+		}
+		if err = sendLeader(); err != nil {
+			logger.Error("error sending leader", "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// leader returns the current leader of the election with the given name
+// and key. If there's no leader, the response will only have the name
+// set. Server.mu must be held by the caller.
+func (s *Server) leader(name string, key string) *pb.LeaderResponse {
+	resp := &pb.LeaderResponse{Name: name}
+
+	kvInfo, exists := s.store[key]
+	if !exists {
+		return resp
+	}
+
+	s.lockMu.RLock()
+	keyLock, locked := s.locks[key]
+	s.lockMu.RUnlock()
+	if !locked {
+		return resp
+	}
+
+	resp.ClientId = keyLock.ClientID
+	resp.FencingToken = keyLock.FencingToken
+	resp.Expires = timestamppb.New(keyLock.expires)
+
+	kvInfo.mu.RLock()
+	if value, err := kvInfo.rawValue(s.keys); err == nil {
+		resp.Value = value
+	}
+	kvInfo.mu.RUnlock()
+	return resp
+}
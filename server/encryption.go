@@ -0,0 +1,373 @@
+package server
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"google.golang.org/grpc/codes"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// DefaultEncryptionKeyID is the ID used for the encryption key when
+// EncryptionConfig.KeyID isn't set
+const DefaultEncryptionKeyID = "default"
+
+// snapshotEncryption identifies the algorithm used for encrypted snapshots
+const snapshotEncryption = "AES-GCM"
+
+// maxEncryptionKeyIDLength is the maximum length of a key ID, which
+// is stored in a single byte ahead of encrypted values
+const maxEncryptionKeyIDLength = 255
+
+var ErrDecryptValue = KQError{
+	Message: "unable to decrypt stored value",
+	Code:    codes.Internal,
+}
+
+// EncryptionConfig configures AES-GCM encryption of snapshots and,
+// optionally, of values held in memory.
+type EncryptionConfig struct {
+	// KeyID identifies the current key. It's stored alongside everything
+	// encrypted with the key, so data encrypted before a key rotation
+	// can be decrypted with the right key (see PreviousKeys). Key IDs
+	// are lowercased, as option names (including the keys of
+	// PreviousKeys) are case-insensitive. Default: "default"
+	KeyID string `json:"key_id" yaml:"key_id" mapstructure:"key_id"`
+
+	// Key is the base64-encoded 16, 24 or 32 byte key used to encrypt
+	// new data (for AES-128, AES-192 or AES-256)
+	Key string `json:"-" yaml:"key" mapstructure:"key"`
+
+	// KeyFile is the path to a file containing the base64-encoded key,
+	// used instead of Key
+	KeyFile string `json:"key_file" yaml:"key_file" mapstructure:"key_file"`
+
+	// PreviousKeys maps the IDs of keys used before a rotation to their
+	// base64-encoded values. They're only used to decrypt data.
+	PreviousKeys map[string]string `json:"-" yaml:"previous_keys" mapstructure:"previous_keys"`
+
+	// Values, if true, keeps values (and their revisions) encrypted in
+	// memory, decrypting them when they're read. Otherwise, only
+	// snapshots are encrypted. For lists and hashes, each element (or
+	// field value) is encrypted. Like key names, hash field names and
+	// the members of sets and sorted sets are looked up by name, so
+	// they aren't encrypted.
+	Values bool `json:"values" yaml:"values" mapstructure:"values"`
+}
+
+func (e EncryptionConfig) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Bool("enabled", e.Key != "" || e.KeyFile != ""),
+		slog.String("key_id", e.KeyID),
+		slog.String("key_file", e.KeyFile),
+		slog.Int("previous_keys", len(e.PreviousKeys)),
+		slog.Bool("values", e.Values),
+	)
+}
+
+// keyring holds the AEAD ciphers for the current encryption key, and
+// any previous keys, by key ID
+type keyring struct {
+	keyID string
+	keys  map[string]cipher.AEAD
+}
+
+// newKeyring returns a keyring for the given configuration, or nil if
+// no key is configured
+func newKeyring(cfg EncryptionConfig) (*keyring, error) {
+	if cfg.Key != "" && cfg.KeyFile != "" {
+		return nil, errors.New("only one of encryption.key and encryption.key_file may be set")
+	}
+
+	key := cfg.Key
+	if cfg.KeyFile != "" {
+		data, err := os.ReadFile(cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read encryption key file: %w", err)
+		}
+		key = string(data)
+	}
+
+	if key == "" {
+		if cfg.Values || len(cfg.PreviousKeys) > 0 {
+			return nil, errors.New("encryption requires encryption.key or encryption.key_file")
+		}
+		return nil, nil
+	}
+
+	keyID := strings.ToLower(cfg.KeyID)
+	if keyID == "" {
+		keyID = DefaultEncryptionKeyID
+	}
+
+	k := &keyring{
+		keyID: keyID,
+		keys:  make(map[string]cipher.AEAD, len(cfg.PreviousKeys)+1),
+	}
+	if err := k.addKey(keyID, key); err != nil {
+		return nil, err
+	}
+	for previousID, previousKey := range cfg.PreviousKeys {
+		previousID = strings.ToLower(previousID)
+		if previousID == keyID {
+			return nil, fmt.Errorf(
+				"encryption key '%s' is also listed in previous_keys",
+				keyID,
+			)
+		}
+		if err := k.addKey(previousID, previousKey); err != nil {
+			return nil, err
+		}
+	}
+	return k, nil
+}
+
+func (k *keyring) addKey(keyID string, encodedKey string) error {
+	if keyID == "" || len(keyID) > maxEncryptionKeyIDLength {
+		return fmt.Errorf(
+			"encryption key ID must be between 1 and %d bytes",
+			maxEncryptionKeyIDLength,
+		)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil {
+		return fmt.Errorf("encryption key '%s' isn't valid base64: %w", keyID, err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return fmt.Errorf("encryption key '%s': %w", keyID, err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return fmt.Errorf("encryption key '%s': %w", keyID, err)
+	}
+	k.keys[keyID] = aead
+	return nil
+}
+
+// encrypt encrypts data with the current key, returning the nonce
+// followed by the ciphertext
+func (k *keyring) encrypt(data []byte) []byte {
+	aead := k.keys[k.keyID]
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(data)+aead.Overhead())
+	// crypto/rand doesn't fail on supported platforms, and there's
+	// no safe way to continue if it does
+	if _, err := rand.Read(nonce); err != nil {
+		panic(fmt.Sprintf("unable to generate nonce: %s", err))
+	}
+	return aead.Seal(nonce, nonce, data, nil)
+}
+
+// decrypt decrypts data returned by encrypt, using the key with the
+// given ID
+func (k *keyring) decrypt(keyID string, data []byte) ([]byte, error) {
+	if k == nil {
+		return nil, errors.New("no encryption key configured")
+	}
+	aead, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key '%s'", keyID)
+	}
+	if len(data) < aead.NonceSize() {
+		return nil, errors.New("encrypted data is too short")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+// encryptValue encrypts a value with the current key. The key ID is
+// stored ahead of the encrypted data, prefixed by its length.
+func (k *keyring) encryptValue(value []byte) []byte {
+	data := make([]byte, 0, 1+len(k.keyID))
+	data = append(data, byte(len(k.keyID)))
+	data = append(data, k.keyID...)
+	return append(data, k.encrypt(value)...)
+}
+
+// valueKeyID returns the ID of the key a value returned by
+// encryptValue was encrypted with, and the encrypted data
+func valueKeyID(data []byte) (string, []byte, error) {
+	if len(data) == 0 || len(data) < 1+int(data[0]) {
+		return "", nil, errors.New("encrypted value is too short")
+	}
+	idLen := 1 + int(data[0])
+	return string(data[1:idLen]), data[idLen:], nil
+}
+
+// decryptValue decrypts a value returned by encryptValue
+func (k *keyring) decryptValue(data []byte) ([]byte, error) {
+	keyID, encrypted, err := valueKeyID(data)
+	if err != nil {
+		return nil, err
+	}
+	return k.decrypt(keyID, encrypted)
+}
+
+// canDecryptValue returns an error if the key a value returned by
+// encryptValue was encrypted with isn't available
+func (k *keyring) canDecryptValue(data []byte) error {
+	keyID, _, err := valueKeyID(data)
+	if err != nil {
+		return err
+	}
+	if k == nil {
+		return fmt.Errorf(
+			"value is encrypted with key '%s', but no encryption key is configured",
+			keyID,
+		)
+	}
+	if _, ok := k.keys[keyID]; !ok {
+		return fmt.Errorf("unknown encryption key '%s'", keyID)
+	}
+	return nil
+}
+
+// encryptsElements returns true if the elements of values of the given
+// type are encrypted when EncryptionConfig.Values is set
+func encryptsElements(valueType pb.ValueType) bool {
+	return valueType == pb.ValueType_LIST || valueType == pb.ValueType_HASH
+}
+
+// encodeElement returns a list element or hash field value as it's
+// stored, encrypted with keys if the key's elements are encrypted.
+// keyValue.mu must be held by the caller.
+func (kv *keyValue) encodeElement(value []byte, keys *keyring) []byte {
+	if !kv.Encrypted {
+		return value
+	}
+	return keys.encryptValue(value)
+}
+
+// rawElement reverses encodeElement for a stored list element or hash
+// field value. keyValue.mu must be held by the caller.
+func (kv *keyValue) rawElement(data []byte, keys *keyring) ([]byte, error) {
+	if !kv.Encrypted {
+		return data, nil
+	}
+	value, err := keys.decryptValue(data)
+	if err != nil {
+		return nil, ErrDecryptValue
+	}
+	return value, nil
+}
+
+// decryptElements decrypts each of the key's list elements or hash
+// field values, if they're encrypted. keyValue.mu must be held by the
+// caller.
+func (kv *keyValue) decryptElements(keys *keyring) error {
+	if !kv.Encrypted {
+		return nil
+	}
+	for i, v := range kv.List {
+		value, err := kv.rawElement(v, keys)
+		if err != nil {
+			return err
+		}
+		kv.List[i] = value
+	}
+	for field, v := range kv.Fields {
+		value, err := kv.rawElement(v, keys)
+		if err != nil {
+			return err
+		}
+		kv.Fields[field] = value
+	}
+	kv.Encrypted = false
+	return nil
+}
+
+// encryptElements encrypts each of the key's (unencrypted) list elements
+// or hash field values with keys, if keys isn't nil. keyValue.mu must be
+// held by the caller.
+func (kv *keyValue) encryptElements(keys *keyring) {
+	if keys == nil || kv.Encrypted || !encryptsElements(kv.Type) {
+		return
+	}
+	kv.Encrypted = true
+	for i, v := range kv.List {
+		kv.List[i] = kv.encodeElement(v, keys)
+	}
+	for field, v := range kv.Fields {
+		kv.Fields[field] = kv.encodeElement(v, keys)
+	}
+}
+
+// canDecrypt returns an error if the key its value (or its elements)
+// were encrypted with isn't available
+func (kv *keyValue) canDecrypt(keys *keyring) error {
+	if !kv.Encrypted {
+		return nil
+	}
+	if kv.Type == pb.ValueType_STRING {
+		return keys.canDecryptValue(kv.Value)
+	}
+	for _, v := range kv.List {
+		if err := keys.canDecryptValue(v); err != nil {
+			return err
+		}
+	}
+	for _, v := range kv.Fields {
+		if err := keys.canDecryptValue(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encryptedSnapshot is stored in place of a snapshot's JSON when
+// encryption is enabled. It's JSON itself, so it can still be stored
+// in a JSONB column.
+type encryptedSnapshot struct {
+	// Encryption is the algorithm used (snapshotEncryption)
+	Encryption string `json:"encryption"`
+	// KeyID is the ID of the key used to encrypt Data
+	KeyID string `json:"key_id"`
+	// Data is the nonce, followed by the encrypted snapshot
+	Data []byte `json:"data"`
+}
+
+// encryptSnapshot encrypts snapshot data with the current key
+func (k *keyring) encryptSnapshot(data []byte) ([]byte, error) {
+	return json.Marshal(
+		encryptedSnapshot{
+			Encryption: snapshotEncryption,
+			KeyID:      k.keyID,
+			Data:       k.encrypt(data),
+		},
+	)
+}
+
+// decryptSnapshot returns the decrypted data for a snapshot encrypted
+// with encryptSnapshot, using the key identified in the snapshot. Data
+// for unencrypted snapshots is returned as-is.
+func (k *keyring) decryptSnapshot(data []byte) ([]byte, error) {
+	var snapshot encryptedSnapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil ||
+		snapshot.Encryption == "" {
+		return data, nil
+	}
+	if snapshot.Encryption != snapshotEncryption {
+		return nil, fmt.Errorf(
+			"unsupported snapshot encryption '%s'",
+			snapshot.Encryption,
+		)
+	}
+	if k == nil {
+		return nil, fmt.Errorf(
+			"snapshot is encrypted with key '%s', but no encryption key is configured",
+			snapshot.KeyID,
+		)
+	}
+	decrypted, err := k.decrypt(snapshot.KeyID, snapshot.Data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt snapshot: %w", err)
+	}
+	return decrypted, nil
+}
@@ -27,6 +27,7 @@ const (
 	Accessed                 // Key value has been accessed via Get or Inspect
 	LifespanSet              // When Lifespan is initially set, or changed
 	LifespanRenewed          // When a lifespan is renewed via update, without changing the duration
+	LifespanCleared          // When a lifespan is removed via Persist
 )
 
 // KeyEvent is an event that can occur with a key. These
@@ -61,6 +62,8 @@ func (ke KeyEvent) String() string {
 		return "LIFESPAN_SET"
 	case LifespanRenewed:
 		return "LIFESPAN_RENEWED"
+	case LifespanCleared:
+		return "LIFESPAN_CLEARED"
 	case NoEvent:
 		return "NO_EVENT"
 	default:
@@ -89,15 +92,54 @@ type Event struct {
 	// ClientID is the ID of the client associated with the event.
 	// If the event was triggered internally, this will be 'keyquarry'
 	ClientID string `json:"client_id"`
+
+	// TxnID is the ID of the transaction that triggered the event,
+	// if any. See [Server.Txn]
+	TxnID string `json:"txn_id,omitempty"`
+
+	// Fields are the names of the fields of a hash value changed by the
+	// event, if any. See [Server.HSet]
+	Fields []string `json:"fields,omitempty"`
+
+	// Topic is the topic a message was published to, if the event is a
+	// message sent by [Server.Publish], rather than an event for a key
+	Topic string `json:"topic,omitempty"`
+
+	// Payload is the payload of a message published to Topic
+	Payload []byte `json:"payload,omitempty"`
 }
 
 func (e Event) LogValue() slog.Value {
-	return slog.GroupValue(
-		slog.String("key", e.Key),
+	if e.Topic != "" {
+		namespace, topic := splitNamespacedKey(e.Topic)
+		attrs := []slog.Attr{
+			slog.String("topic", topic),
+			slog.Time("time", e.Time),
+			slog.String("client_id", e.ClientID),
+			slog.Int("payload_size", len(e.Payload)),
+		}
+		if namespace != "" {
+			attrs = append(attrs, slog.String("namespace", namespace))
+		}
+		return slog.GroupValue(attrs...)
+	}
+	namespace, key := splitNamespacedKey(e.Key)
+	attrs := []slog.Attr{
+		slog.String("key", key),
 		slog.String("event", e.Event.String()),
 		slog.Time("time", e.Time),
 		slog.String("client_id", e.ClientID),
-	)
+	}
+	if namespace != "" {
+		attrs = append(attrs, slog.String("namespace", namespace))
+	}
+	if e.TxnID != "" {
+		attrs = append(attrs, slog.String("txn_id", e.TxnID))
+	}
+	if len(e.Fields) > 0 {
+		attrs = append(attrs, slog.Any("fields", e.Fields))
+	}
+	return slog.GroupValue(attrs...)
 }
 
 // eventWorker receives events from the eventStream on
@@ -120,6 +162,10 @@ type eventWorker struct {
 	// the keys in this list. If nil, all keys will be forwarded.
 	includeKeys []string
 
+	// topics is true if the worker forwards messages published to topics,
+	// rather than events for keys
+	topics bool
+
 	// out is the channel the worker will re-broadcast events to
 	out chan Event
 
@@ -232,6 +278,8 @@ EventLoop:
 			switch {
 			// case !ok:
 			// 	break EventLoop
+			case w.topics != (event.Topic != ""):
+				continue EventLoop
 			case w.includeEvents != nil && !sliceContains(
 				w.includeEvents,
 				event.Event,
@@ -374,6 +422,28 @@ func (e *eventStream) Subscribe(
 	name string, // subscriber name
 	keys []string, // keys to subscribe to - leave empty to subscribe to all keys
 	events []KeyEvent, // events to subscribe to - leave empty to subscribe to all events
+) (<-chan Event, error) {
+	return e.subscribe(ctx, name, keys, events, false)
+}
+
+// SubscribeTopics creates a new eventWorker and returns a channel that
+// will receive messages published to any topic. The channel will be
+// closed when the subscriber is removed via Unsubscribe.
+func (e *eventStream) SubscribeTopics(
+	ctx context.Context,
+	name string,
+) (<-chan Event, error) {
+	return e.subscribe(ctx, name, nil, nil, true)
+}
+
+// subscribe creates and starts a new eventWorker, forwarding either events
+// for keys or, if topics is true, messages published to topics
+func (e *eventStream) subscribe(
+	ctx context.Context,
+	name string,
+	keys []string,
+	events []KeyEvent,
+	topics bool,
 ) (<-chan Event, error) {
 	e.mu.Lock()
 	defer e.mu.Unlock()
@@ -414,6 +484,7 @@ func (e *eventStream) Subscribe(
 	}
 
 	w := newEventWorker(e, name, events, keys)
+	w.topics = topics
 	e.workers[name] = w
 	e.logger.Log(
 		context.Background(),
@@ -0,0 +1,32 @@
+package server
+
+// issueFencingToken returns a new fencing token for the given key. Tokens
+// are issued from a single counter shared by all keys, so a token for a
+// key is greater than any issued for a previous key with the same name,
+// and only the latest token needs to be kept for each key while it
+// exists. Server.lockMu must be held by the caller.
+func (s *Server) issueFencingToken(key string) uint64 {
+	s.fencingToken++
+	s.fencingTokens[key] = s.fencingToken
+	return s.fencingToken
+}
+
+// checkFencingToken returns ErrStaleFencingToken if a token was provided,
+// and it's older than the latest token issued for the given key, or no
+// token has been issued for the key since it was created.
+// Server.lockMu must be held by the caller.
+func (s *Server) checkFencingToken(key string, token *uint64) error {
+	if token == nil {
+		return nil
+	}
+	if latest, ok := s.fencingTokens[key]; !ok || *token < latest {
+		s.logger.Info(
+			"rejected stale fencing token",
+			"key", key,
+			"fencing_token", *token,
+			"latest", latest,
+		)
+		return ErrStaleFencingToken
+	}
+	return nil
+}
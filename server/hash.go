@@ -0,0 +1,412 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"log/slog"
+	"math"
+	"slices"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrNoHashFields = KQError{
+		Message: "at least one field is required",
+		Code:    codes.InvalidArgument,
+	}
+	ErrEmptyHashField = KQError{
+		Message: "field name must not be empty",
+		Code:    codes.InvalidArgument,
+	}
+	ErrHashFieldNotFound = KQError{
+		Message: "field not found",
+		Code:    codes.NotFound,
+	}
+)
+
+// cloneFields returns a deep copy of the given hash fields
+func cloneFields(fields map[string][]byte) map[string][]byte {
+	if fields == nil {
+		return nil
+	}
+	rv := make(map[string][]byte, len(fields))
+	for field, v := range fields {
+		value := make([]byte, len(v))
+		copy(value, v)
+		rv[field] = value
+	}
+	return rv
+}
+
+// HSet sets the given fields of a hash, creating the key if it doesn't
+// exist. The total size of the field names and values in the hash is
+// limited by [Config.MaxValueSize].
+func (s *Server) HSet(ctx context.Context, in *pb.HSetRequest) (
+	*pb.HSetResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request to set hash fields",
+		slog.String("key", in.Key),
+		slog.Int("fields", len(in.Fields)),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if _, _, err := validateSetRequest(cfg, &pb.KeyValue{Key: in.Key}); err != nil {
+		return nil, err
+	}
+	if len(in.Fields) == 0 {
+		return nil, ErrNoHashFields
+	}
+	var total uint64
+	for field, v := range in.Fields {
+		if field == "" {
+			return nil, ErrEmptyHashField
+		}
+		total += uint64(len(field) + len(v))
+	}
+	if cfg.MaxValueSize > 0 && total > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kvInfo, isNew, err := s.typedKeyForWrite(
+		cfg,
+		clientID,
+		key,
+		pb.ValueType_HASH,
+		true,
+	)
+	if err != nil {
+		logger.Info("unable to set hash fields", "error", err)
+		return nil, err
+	}
+
+	kvInfo.mu.Lock()
+	defer kvInfo.mu.Unlock()
+
+	size := kvInfo.Size
+	var added uint64
+	for field, v := range in.Fields {
+		current, exists := kvInfo.Fields[field]
+		switch {
+		case exists:
+			currentValue, err := kvInfo.rawElement(current, s.keys)
+			if err != nil {
+				return nil, err
+			}
+			size -= uint64(len(currentValue))
+		default:
+			size += uint64(len(field))
+			added++
+		}
+		size += uint64(len(v))
+	}
+	if cfg.MaxValueSize > 0 && size > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+
+	if kvInfo.Fields == nil {
+		kvInfo.Fields = make(map[string][]byte, len(in.Fields))
+	}
+	for field, v := range cloneFields(in.Fields) {
+		kvInfo.Fields[field] = kvInfo.encodeElement(v, s.valueKeys)
+	}
+	s.updateValue(kvInfo, clientID, size, isNew, sortedFields(in.Fields)...)
+
+	return &pb.HSetResponse{Added: added, Version: kvInfo.Version}, nil
+}
+
+// HGet returns the value of a field of a hash
+func (s *Server) HGet(ctx context.Context, in *pb.HGetRequest) (
+	*pb.HGetResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request for hash field",
+		slog.String("key", in.Key),
+		slog.String("field", in.Field),
+	)
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	kvInfo, err := s.typedKeyForRead(clientID, key, pb.ValueType_HASH)
+	if err != nil {
+		return nil, err
+	}
+
+	kvInfo.mu.RLock()
+	defer kvInfo.mu.RUnlock()
+
+	v, ok := kvInfo.Fields[in.Field]
+	if !ok {
+		return nil, ErrHashFieldNotFound
+	}
+	v, err = kvInfo.rawElement(v, s.keys)
+	if err != nil {
+		return nil, err
+	}
+	value := make([]byte, len(v))
+	copy(value, v)
+
+	t := time.Now()
+	s.emit(key, Accessed, clientID, &t)
+	return &pb.HGetResponse{Value: value}, nil
+}
+
+// HDel deletes the given fields from a hash. Fields which don't exist
+// are ignored. If none of the fields existed, the key's version is left
+// unchanged.
+func (s *Server) HDel(ctx context.Context, in *pb.HDelRequest) (
+	*pb.HDelResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request to delete hash fields",
+		slog.String("key", in.Key),
+		slog.Any("fields", in.Fields),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if _, _, err := validateSetRequest(cfg, &pb.KeyValue{Key: in.Key}); err != nil {
+		return nil, err
+	}
+	if len(in.Fields) == 0 {
+		return nil, ErrNoHashFields
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kvInfo, _, err := s.typedKeyForWrite(
+		cfg,
+		clientID,
+		key,
+		pb.ValueType_HASH,
+		false,
+	)
+	if err != nil {
+		logger.Info("unable to delete hash fields", "error", err)
+		return nil, err
+	}
+
+	kvInfo.mu.Lock()
+	defer kvInfo.mu.Unlock()
+
+	size := kvInfo.Size
+	deleted := make([]string, 0, len(in.Fields))
+	for _, field := range in.Fields {
+		v, ok := kvInfo.Fields[field]
+		if !ok {
+			continue
+		}
+		v, err = kvInfo.rawElement(v, s.keys)
+		if err != nil {
+			return nil, err
+		}
+		size -= uint64(len(field) + len(v))
+		delete(kvInfo.Fields, field)
+		deleted = append(deleted, field)
+	}
+	if len(deleted) > 0 {
+		slices.Sort(deleted)
+		s.updateValue(kvInfo, clientID, size, false, deleted...)
+	}
+
+	return &pb.HDelResponse{
+		Deleted: uint64(len(deleted)),
+		Version: kvInfo.Version,
+	}, nil
+}
+
+// HGetAll returns all the fields of a hash
+func (s *Server) HGetAll(ctx context.Context, in *pb.Key) (
+	*pb.HGetAllResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info("request for hash fields", slog.String("key", in.Key))
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	kvInfo, err := s.typedKeyForRead(clientID, key, pb.ValueType_HASH)
+	if err != nil {
+		return nil, err
+	}
+
+	kvInfo.mu.RLock()
+	fields := make(map[string][]byte, len(kvInfo.Fields))
+	for field, v := range kvInfo.Fields {
+		value, valueErr := kvInfo.rawElement(v, s.keys)
+		if valueErr != nil {
+			kvInfo.mu.RUnlock()
+			return nil, valueErr
+		}
+		fields[field] = bytes.Clone(value)
+	}
+	kvInfo.mu.RUnlock()
+
+	t := time.Now()
+	s.emit(key, Accessed, clientID, &t)
+	return &pb.HGetAllResponse{Fields: fields}, nil
+}
+
+// HIncrBy adds the requested amount to the value of a field of a hash,
+// treating it as a base-10 signed 64-bit integer, as with
+// [Server.Increment]. If the key or field doesn't exist, it's created
+// with the requested amount as its value.
+func (s *Server) HIncrBy(ctx context.Context, in *pb.HIncrByRequest) (
+	*pb.HIncrByResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request to increment hash field",
+		slog.String("key", in.Key),
+		slog.String("field", in.Field),
+		slog.Int64("amount", in.Amount),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if _, _, err := validateSetRequest(cfg, &pb.KeyValue{Key: in.Key}); err != nil {
+		return nil, err
+	}
+	if in.Field == "" {
+		return nil, ErrEmptyHashField
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// A new key would only hold the requested amount, so check that fits
+	// before the key is created
+	if _, exists := s.store[key]; !exists && cfg.MaxValueSize > 0 {
+		size := len(in.Field) + len(strconv.FormatInt(in.Amount, 10))
+		if uint64(size) > cfg.MaxValueSize {
+			return nil, ErrValueTooLarge
+		}
+	}
+
+	kvInfo, isNew, err := s.typedKeyForWrite(
+		cfg,
+		clientID,
+		key,
+		pb.ValueType_HASH,
+		true,
+	)
+	if err != nil {
+		logger.Info("unable to increment hash field", "error", err)
+		return nil, err
+	}
+
+	kvInfo.mu.Lock()
+	defer kvInfo.mu.Unlock()
+
+	var current int64
+	currentValue, exists := kvInfo.Fields[in.Field]
+	if exists {
+		currentValue, err = kvInfo.rawElement(currentValue, s.keys)
+		if err != nil {
+			return nil, err
+		}
+		current, err = strconv.ParseInt(string(currentValue), 10, 64)
+		if err != nil {
+			logger.Info("unable to parse field as integer", "error", err)
+			return nil, ErrNotAnInteger
+		}
+	}
+
+	if (in.Amount > 0 && current > math.MaxInt64-in.Amount) ||
+		(in.Amount < 0 && current < math.MinInt64-in.Amount) {
+		return nil, ErrIntegerOverflow
+	}
+	newValue := current + in.Amount
+	value := []byte(strconv.FormatInt(newValue, 10))
+
+	size := kvInfo.Size + uint64(len(value))
+	switch {
+	case exists:
+		size -= uint64(len(currentValue))
+	default:
+		size += uint64(len(in.Field))
+	}
+	if cfg.MaxValueSize > 0 && size > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+
+	if kvInfo.Fields == nil {
+		kvInfo.Fields = make(map[string][]byte, 1)
+	}
+	kvInfo.Fields[in.Field] = kvInfo.encodeElement(value, s.valueKeys)
+	s.updateValue(kvInfo, clientID, size, isNew, in.Field)
+
+	return &pb.HIncrByResponse{Value: newValue, Version: kvInfo.Version}, nil
+}
+
+// sortedFields returns the names of the given fields, sorted
+func sortedFields(fields map[string][]byte) []string {
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	slices.Sort(names)
+	return names
+}
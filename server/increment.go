@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"math"
+	"strconv"
+)
+
+// Increment adds the requested amount to the value of a key, treating it
+// as a base-10 signed 64-bit integer, and returns the new value. If the
+// key doesn't exist, it's created with the requested amount as its value.
+// The new value is written the same way as with Set, so the key's version
+// is incremented, a revision is recorded, and an [Updated] or [Created]
+// event is emitted.
+func (s *Server) Increment(ctx context.Context, in *pb.IncrementRequest) (
+	*pb.IncrementResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	var amount int64 = 1
+	if in.Amount != nil {
+		amount = *in.Amount
+	}
+
+	logger := s.requestLogger(ctx)
+	logger.Info(
+		"request to increment value",
+		slog.Group(
+			"request",
+			slog.String("key", in.Key),
+			slog.Int64("amount", amount),
+			slog.Any("lifespan", in.Lifespan),
+		),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	kv := &pb.KeyValue{Key: in.Key, Lifespan: in.Lifespan}
+	_, expireAfter, err := validateSetRequest(cfg, kv)
+	if err != nil {
+		return nil, err
+	}
+	kv.Key = s.namespacedKey(ctx, kv.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Check for locks before looking at the current value, so another
+	// client's locked value isn't revealed by the error
+	clientID := s.ClientID(ctx)
+	if err = s.checkSet(cfg, clientID, kv); err != nil {
+		logger.Info("unable to increment key", "error", err)
+		return nil, err
+	}
+
+	var current int64
+	if kvInfo, exists := s.store[kv.Key]; exists {
+		kvInfo.mu.RLock()
+		value, valueErr := kvInfo.rawValue(s.keys)
+		kvInfo.mu.RUnlock()
+		if valueErr != nil {
+			logger.Error("unable to read value", "error", valueErr)
+			return nil, valueErr
+		}
+		current, err = strconv.ParseInt(string(value), 10, 64)
+		if err != nil {
+			logger.Info("unable to parse value as integer", "error", err)
+			return nil, ErrNotAnInteger
+		}
+	}
+
+	if (amount > 0 && current > math.MaxInt64-amount) ||
+		(amount < 0 && current < math.MinInt64-amount) {
+		return nil, ErrIntegerOverflow
+	}
+	newValue := current + amount
+
+	kv.Value = []byte(strconv.FormatInt(newValue, 10))
+	if cfg.MaxValueSize > 0 && uint64(len(kv.Value)) > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+
+	rv, err := s.setKey(logger, cfg, clientID, kv, nil, expireAfter)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.IncrementResponse{
+		Value:   newValue,
+		IsNew:   rv.IsNew,
+		Version: rv.Version,
+	}, nil
+}
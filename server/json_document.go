@@ -0,0 +1,594 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNotJSON = KQError{
+		Message: "value is not valid JSON",
+		Code:    codes.FailedPrecondition,
+	}
+	ErrInvalidJSONPath = KQError{
+		Message: "invalid JSONPath expression",
+		Code:    codes.InvalidArgument,
+	}
+	ErrJSONPathNotFound = KQError{
+		Message: "no element found at path",
+		Code:    codes.NotFound,
+	}
+	ErrInvalidJSONPatch = KQError{
+		Message: "invalid JSON patch",
+		Code:    codes.InvalidArgument,
+	}
+)
+
+// errJSONPointerNotFound is returned when a JSON pointer in a patch
+// operation doesn't refer to an existing element
+var errJSONPointerNotFound = errors.New("path not found")
+
+// jsonPatchOp is an operation in a JSON Patch document (RFC 6902)
+type jsonPatchOp struct {
+	Op    string          `json:"op"`
+	Path  *string         `json:"path"`
+	From  *string         `json:"from"`
+	Value json.RawMessage `json:"value"`
+}
+
+// jsonPatchError returns a FailedPrecondition error describing why the
+// patch operation at the given index couldn't be applied
+func jsonPatchError(i int, err error) KQError {
+	return KQError{
+		Message: fmt.Sprintf("patch operation %d failed: %s", i, err),
+		Code:    codes.FailedPrecondition,
+	}
+}
+
+// decodeJSON decodes a JSON document, keeping numbers as [json.Number]
+// so they're encoded again without losing precision
+func decodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+	return v, nil
+}
+
+// encodeJSON encodes a JSON document decoded by decodeJSON. Object
+// members are sorted by name.
+func encodeJSON(v any) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// parseJSONPath parses a JSONPath expression selecting a single element,
+// returning its object member names and array indexes. Member names are
+// returned as strings, and indexes as ints.
+func parseJSONPath(path string) ([]any, error) {
+	if path == "" || path == "$" {
+		return nil, nil
+	}
+	rest, found := strings.CutPrefix(path, "$")
+	if !found {
+		return nil, ErrInvalidJSONPath
+	}
+
+	var steps []any
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, ErrInvalidJSONPath
+			}
+			steps = append(steps, rest[:end])
+			rest = rest[end:]
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, ErrInvalidJSONPath
+			}
+			selector := rest[1:end]
+			rest = rest[end+1:]
+			if len(selector) >= 2 &&
+				(selector[0] == '\'' || selector[0] == '"') &&
+				selector[len(selector)-1] == selector[0] {
+				steps = append(steps, selector[1:len(selector)-1])
+				continue
+			}
+			idx, err := strconv.Atoi(selector)
+			if err != nil {
+				return nil, ErrInvalidJSONPath
+			}
+			steps = append(steps, idx)
+		default:
+			return nil, ErrInvalidJSONPath
+		}
+	}
+	return steps, nil
+}
+
+// selectJSONPath returns the element of the document selected by the
+// given JSONPath steps (see parseJSONPath)
+func selectJSONPath(doc any, steps []any) (any, error) {
+	node := doc
+	for _, step := range steps {
+		switch n := node.(type) {
+		case map[string]any:
+			name, ok := step.(string)
+			if !ok {
+				return nil, ErrJSONPathNotFound
+			}
+			if node, ok = n[name]; !ok {
+				return nil, ErrJSONPathNotFound
+			}
+		case []any:
+			idx, ok := step.(int)
+			if !ok {
+				return nil, ErrJSONPathNotFound
+			}
+			if idx < 0 {
+				idx += len(n)
+			}
+			if idx < 0 || idx >= len(n) {
+				return nil, ErrJSONPathNotFound
+			}
+			node = n[idx]
+		default:
+			return nil, ErrJSONPathNotFound
+		}
+	}
+	return node, nil
+}
+
+// parseJSONPointer parses a JSON Pointer (RFC 6901) into its reference
+// tokens
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer '%s'", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		token = strings.ReplaceAll(token, "~1", "/")
+		tokens[i] = strings.ReplaceAll(token, "~0", "~")
+	}
+	return tokens, nil
+}
+
+// arrayIndex parses a JSON pointer reference token as an index of an
+// array of the given length. If end is true, the index may refer to the
+// end of the array, either as "-" or as the length of the array.
+func arrayIndex(token string, length int, end bool) (int, error) {
+	if end && token == "-" {
+		return length, nil
+	}
+	if token == "" || (len(token) > 1 && token[0] == '0') ||
+		strings.TrimLeft(token, "0123456789") != "" {
+		return 0, fmt.Errorf("invalid array index '%s'", token)
+	}
+	idx, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, fmt.Errorf("invalid array index '%s'", token)
+	}
+	if idx > length || (idx == length && !end) {
+		return 0, errJSONPointerNotFound
+	}
+	return idx, nil
+}
+
+// getJSONPointer returns the element of the document at the given
+// pointer tokens
+func getJSONPointer(node any, tokens []string) (any, error) {
+	for _, token := range tokens {
+		switch n := node.(type) {
+		case map[string]any:
+			var ok bool
+			if node, ok = n[token]; !ok {
+				return nil, errJSONPointerNotFound
+			}
+		case []any:
+			idx, err := arrayIndex(token, len(n), false)
+			if err != nil {
+				return nil, err
+			}
+			node = n[idx]
+		default:
+			return nil, errJSONPointerNotFound
+		}
+	}
+	return node, nil
+}
+
+// addJSONPointer adds the value to the document at the given pointer
+// tokens, returning the updated document. Existing object members are
+// replaced, while values are inserted into arrays.
+func addJSONPointer(node any, tokens []string, value any) (any, error) {
+	if len(tokens) == 0 {
+		return value, nil
+	}
+	token := tokens[0]
+	switch n := node.(type) {
+	case map[string]any:
+		if len(tokens) == 1 {
+			n[token] = value
+			return n, nil
+		}
+		child, ok := n[token]
+		if !ok {
+			return nil, errJSONPointerNotFound
+		}
+		child, err := addJSONPointer(child, tokens[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		n[token] = child
+		return n, nil
+	case []any:
+		if len(tokens) == 1 {
+			idx, err := arrayIndex(token, len(n), true)
+			if err != nil {
+				return nil, err
+			}
+			return slices.Insert(n, idx, value), nil
+		}
+		idx, err := arrayIndex(token, len(n), false)
+		if err != nil {
+			return nil, err
+		}
+		child, err := addJSONPointer(n[idx], tokens[1:], value)
+		if err != nil {
+			return nil, err
+		}
+		n[idx] = child
+		return n, nil
+	default:
+		return nil, errJSONPointerNotFound
+	}
+}
+
+// removeJSONPointer removes the element of the document at the given
+// pointer tokens, returning the updated document
+func removeJSONPointer(node any, tokens []string) (any, error) {
+	if len(tokens) == 0 {
+		return nil, errors.New("can't remove the whole document")
+	}
+	token := tokens[0]
+	switch n := node.(type) {
+	case map[string]any:
+		child, ok := n[token]
+		if !ok {
+			return nil, errJSONPointerNotFound
+		}
+		if len(tokens) == 1 {
+			delete(n, token)
+			return n, nil
+		}
+		child, err := removeJSONPointer(child, tokens[1:])
+		if err != nil {
+			return nil, err
+		}
+		n[token] = child
+		return n, nil
+	case []any:
+		idx, err := arrayIndex(token, len(n), false)
+		if err != nil {
+			return nil, err
+		}
+		if len(tokens) == 1 {
+			return slices.Delete(n, idx, idx+1), nil
+		}
+		child, err := removeJSONPointer(n[idx], tokens[1:])
+		if err != nil {
+			return nil, err
+		}
+		n[idx] = child
+		return n, nil
+	default:
+		return nil, errJSONPointerNotFound
+	}
+}
+
+// jsonEqual returns true if the given decoded JSON values are equal, as
+// described for the "test" operation in RFC 6902
+func jsonEqual(a any, b any) bool {
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			other, exists := bv[k]
+			if !exists || !jsonEqual(v, other) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if av == bv {
+			return true
+		}
+		af, aErr := av.Float64()
+		bf, bErr := bv.Float64()
+		return aErr == nil && bErr == nil && af == bf
+	default:
+		return a == b
+	}
+}
+
+// applyJSONPatch applies the operations of a JSON Patch document to the
+// given document, returning the patched document. The document may be
+// modified even if an error is returned.
+func applyJSONPatch(doc any, ops []jsonPatchOp) (any, error) {
+	for i, op := range ops {
+		if op.Path == nil {
+			return nil, jsonPatchError(i, errors.New("missing path"))
+		}
+		path, err := parseJSONPointer(*op.Path)
+		if err != nil {
+			return nil, jsonPatchError(i, err)
+		}
+
+		var value any
+		switch op.Op {
+		case "add", "replace", "test":
+			if op.Value == nil {
+				return nil, jsonPatchError(i, errors.New("missing value"))
+			}
+			if value, err = decodeJSON(op.Value); err != nil {
+				return nil, jsonPatchError(i, err)
+			}
+		}
+
+		var from []string
+		switch op.Op {
+		case "move", "copy":
+			if op.From == nil {
+				return nil, jsonPatchError(i, errors.New("missing from"))
+			}
+			if from, err = parseJSONPointer(*op.From); err != nil {
+				return nil, jsonPatchError(i, err)
+			}
+			if value, err = getJSONPointer(doc, from); err != nil {
+				return nil, jsonPatchError(i, err)
+			}
+		}
+
+		switch op.Op {
+		case "add":
+			doc, err = addJSONPointer(doc, path, value)
+		case "remove":
+			doc, err = removeJSONPointer(doc, path)
+		case "replace":
+			if _, err = getJSONPointer(doc, path); err == nil {
+				if len(path) == 0 {
+					doc = value
+				} else if doc, err = removeJSONPointer(doc, path); err == nil {
+					doc, err = addJSONPointer(doc, path, value)
+				}
+			}
+		case "move":
+			if len(path) > len(from) && slices.Equal(path[:len(from)], from) {
+				err = errors.New("can't move a value into one of its children")
+			} else if doc, err = removeJSONPointer(doc, from); err == nil {
+				doc, err = addJSONPointer(doc, path, value)
+			}
+		case "copy":
+			// Copy the value, so the copies can be changed independently
+			var data []byte
+			if data, err = encodeJSON(value); err == nil {
+				if value, err = decodeJSON(data); err == nil {
+					doc, err = addJSONPointer(doc, path, value)
+				}
+			}
+		case "test":
+			var current any
+			if current, err = getJSONPointer(doc, path); err == nil &&
+				!jsonEqual(current, value) {
+				err = errors.New("test failed")
+			}
+		default:
+			return nil, KQError{
+				Message: fmt.Sprintf(
+					"%s: unknown operation '%s'",
+					ErrInvalidJSONPatch.Message,
+					op.Op,
+				),
+				Code: ErrInvalidJSONPatch.Code,
+			}
+		}
+		if err != nil {
+			return nil, jsonPatchError(i, err)
+		}
+	}
+	return doc, nil
+}
+
+// JSONGet returns the element of a key's JSON value selected by a
+// JSONPath expression. Only expressions selecting a single element are
+// supported, using dot or bracket notation (ex: `$.a.b[0]`).
+func (s *Server) JSONGet(ctx context.Context, in *pb.JSONGetRequest) (
+	*pb.JSONGetResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request for JSON value",
+		slog.String("key", in.Key),
+		slog.String("path", in.Path),
+	)
+
+	steps, err := parseJSONPath(in.Path)
+	if err != nil {
+		return nil, err
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	kvInfo, err := s.typedKeyForRead(clientID, key, pb.ValueType_STRING)
+	if err != nil {
+		return nil, err
+	}
+
+	kvInfo.mu.RLock()
+	raw, err := kvInfo.rawValue(s.keys)
+	kvInfo.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	doc, err := decodeJSON(raw)
+	if err != nil {
+		return nil, ErrNotJSON
+	}
+
+	element, err := selectJSONPath(doc, steps)
+	if err != nil {
+		return nil, err
+	}
+	value, err := encodeJSON(element)
+	if err != nil {
+		return nil, KQError{Message: err.Error(), Code: codes.Internal}
+	}
+
+	t := time.Now()
+	s.emit(key, Accessed, clientID, &t)
+	return &pb.JSONGetResponse{Value: value}, nil
+}
+
+// JSONPatch applies a JSON Patch (RFC 6902) to a key's JSON value. The
+// operations are applied atomically - if any fails, the value is left
+// unchanged. The patched value is written the same way as with Set, so
+// the key's version is incremented, a revision is recorded, and an
+// [Updated] event is emitted. Object members in the patched value are
+// sorted by name.
+func (s *Server) JSONPatch(ctx context.Context, in *pb.JSONPatchRequest) (
+	*pb.JSONPatchResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request to patch JSON value",
+		slog.String("key", in.Key),
+		slog.Int("patch_size", len(in.Patch)),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	kv := &pb.KeyValue{Key: in.Key}
+	if _, _, err := validateSetRequest(cfg, kv); err != nil {
+		return nil, err
+	}
+	var ops []jsonPatchOp
+	if err := json.Unmarshal(in.Patch, &ops); err != nil {
+		logger.Info("unable to parse patch", "error", err)
+		return nil, ErrInvalidJSONPatch
+	}
+	kv.Key = s.namespacedKey(ctx, kv.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Check for locks before looking at the current value, so another
+	// client's locked value isn't revealed by the error
+	if err := s.checkSet(cfg, clientID, kv); err != nil {
+		logger.Info("unable to patch key", "error", err)
+		return nil, err
+	}
+
+	kvInfo, exists := s.store[kv.Key]
+	if !exists {
+		return nil, ErrKeyNotFound
+	}
+	kvInfo.mu.RLock()
+	valueType := kvInfo.Type
+	value, err := kvInfo.rawValue(s.keys)
+	kvInfo.mu.RUnlock()
+	if valueType != pb.ValueType_STRING {
+		return nil, ErrWrongType
+	}
+	if err != nil {
+		return nil, err
+	}
+	doc, err := decodeJSON(value)
+	if err != nil {
+		return nil, ErrNotJSON
+	}
+
+	if doc, err = applyJSONPatch(doc, ops); err != nil {
+		logger.Info("unable to apply patch", "error", err)
+		return nil, err
+	}
+	if kv.Value, err = encodeJSON(doc); err != nil {
+		return nil, KQError{Message: err.Error(), Code: codes.Internal}
+	}
+	if cfg.MaxValueSize > 0 && uint64(len(kv.Value)) > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+
+	rv, err := s.setKey(logger, cfg, clientID, kv, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.JSONPatchResponse{Version: rv.Version, Hash: rv.Hash}, nil
+}
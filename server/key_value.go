@@ -1,6 +1,7 @@
 package server
 
 import (
+	pb "github.com/arcward/keyquarry/api"
 	"hash/fnv"
 	"log/slog"
 	"net/http"
@@ -21,6 +22,29 @@ type keyValue struct {
 	Version     uint64    `json:"version,omitempty"`
 	// History     *keyValueHistory `json:"-"`
 	CreatedBy string `json:"created_by"`
+	// Labels are arbitrary key/value pairs attached to the key, which
+	// can be matched with a label selector
+	Labels map[string]string `json:"labels,omitempty"`
+	// LeaseID is the lease the key is bound to, which deletes the key
+	// when it expires
+	LeaseID string `json:"lease_id,omitempty"`
+	// Type is the type of the value. Only STRING values are held in
+	// Value, while other types have their own fields.
+	Type pb.ValueType `json:"type,omitempty"`
+	// List holds the values of a LIST
+	List [][]byte `json:"list,omitempty"`
+	// Fields holds the fields of a HASH
+	Fields map[string][]byte `json:"fields,omitempty"`
+	// Members holds the members of a SET
+	Members map[string]struct{} `json:"members,omitempty"`
+	// Scores holds the members of a ZSET, and their scores
+	Scores map[string]float64 `json:"scores,omitempty"`
+	// Compressed is true if Value is compressed (see compressValue).
+	// Size is always the size of the uncompressed value.
+	Compressed bool `json:"compressed,omitempty"`
+	// Encrypted is true if Value (or, for a LIST or HASH, each element
+	// or field value) is encrypted (see EncryptionConfig.Values)
+	Encrypted bool `json:"encrypted,omitempty"`
 	mu        sync.RWMutex
 }
 
@@ -62,9 +86,32 @@ func newKeyValue(
 	return kvInfo
 }
 
+// valueSize returns the total size of the key's value, which for
+// types other than STRING is the total size of its elements
+func (kv *keyValue) valueSize() uint64 {
+	size := uint64(len(kv.Value))
+	for _, v := range kv.List {
+		size += uint64(len(v))
+	}
+	for field, v := range kv.Fields {
+		size += uint64(len(field) + len(v))
+	}
+	for member := range kv.Members {
+		size += uint64(len(member))
+	}
+	for member := range kv.Scores {
+		size += zMemberSize(member)
+	}
+	return size
+}
+
 func (kv *keyValue) LogValue() slog.Value {
 	attrs := make([]slog.Attr, 0, 8)
-	attrs = append(attrs, slog.String("key", kv.Key))
+	namespace, key := splitNamespacedKey(kv.Key)
+	attrs = append(attrs, slog.String("key", key))
+	if namespace != "" {
+		attrs = append(attrs, slog.String("namespace", namespace))
+	}
 	attrs = append(attrs, slog.Uint64("version", kv.Version))
 	attrs = append(attrs, slog.Uint64("hash", kv.Hash))
 	if !kv.Created.IsZero() {
@@ -85,20 +132,25 @@ type keyValueSnapshot struct {
 	Hash        uint64    `json:"hash,omitempty"`
 	Version     uint64    `json:"version"`
 	Timestamp   time.Time `json:"timestamp"`
+	// Compressed and Encrypted are the same as the keyValue it was
+	// taken from
+	Compressed bool `json:"compressed,omitempty"`
+	Encrypted  bool `json:"encrypted,omitempty"`
 }
 
 func newKeySnapshot(kv *keyValue) *keyValueSnapshot {
-	size := len(kv.Value)
-	value := make([]byte, size)
+	value := make([]byte, len(kv.Value))
 	copy(value, kv.Value)
 	return &keyValueSnapshot{
 		Timestamp:   time.Now(),
 		Key:         kv.Key,
-		Size:        uint64(size),
+		Size:        kv.Size,
 		Version:     kv.Version,
 		ContentType: kv.ContentType,
 		Hash:        kv.Hash,
 		Value:       value,
+		Compressed:  kv.Compressed,
+		Encrypted:   kv.Encrypted,
 	}
 }
 
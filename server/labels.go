@@ -0,0 +1,347 @@
+package server
+
+import (
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"regexp"
+	"strings"
+)
+
+const (
+	// maxLabelNameLength is the maximum length of a label value, and of
+	// the name part of a label key
+	maxLabelNameLength = 63
+
+	// maxLabelPrefixLength is the maximum length of the (optional)
+	// prefix part of a label key
+	maxLabelPrefixLength = 253
+)
+
+var (
+	labelNamePattern   = regexp.MustCompile(`^([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$`)
+	labelPrefixPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// validateLabels returns an error if any of the given label keys or values
+// are invalid. As with Kubernetes labels, keys are a name, with an optional
+// DNS subdomain prefix (ex: `example.com/owner`). Names and values must be
+// 63 characters or less, begin and end with an alphanumeric character, and
+// may contain dashes, underscores and dots. Values may also be empty.
+func validateLabels(labels map[string]string) error {
+	for k, v := range labels {
+		if err := validateLabelKey(k); err != nil {
+			return err
+		}
+		if err := validateLabelValue(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateLabelKey(key string) error {
+	name := key
+	if prefix, n, found := strings.Cut(key, "/"); found {
+		if len(prefix) > maxLabelPrefixLength ||
+			!labelPrefixPattern.MatchString(prefix) {
+			return invalidLabelError("key", key)
+		}
+		name = n
+	}
+	if len(name) > maxLabelNameLength || !labelNamePattern.MatchString(name) {
+		return invalidLabelError("key", key)
+	}
+	return nil
+}
+
+func validateLabelValue(value string) error {
+	if value == "" {
+		return nil
+	}
+	if len(value) > maxLabelNameLength || !labelNamePattern.MatchString(value) {
+		return invalidLabelError("value", value)
+	}
+	return nil
+}
+
+func invalidLabelError(kind string, s string) error {
+	return KQError{
+		Message: fmt.Sprintf("invalid label %s '%s'", kind, s),
+		Code:    codes.InvalidArgument,
+	}
+}
+
+// selectorOp is the operator of a labelRequirement
+type selectorOp int
+
+const (
+	opExists selectorOp = iota
+	opNotExists
+	opEquals
+	opNotEquals
+	opIn
+	opNotIn
+)
+
+// labelRequirement is a single expression in a labelSelector
+type labelRequirement struct {
+	key    string
+	op     selectorOp
+	values []string
+}
+
+// matches reports whether the given labels meet the requirement
+func (r labelRequirement) matches(labels map[string]string) bool {
+	v, ok := labels[r.key]
+	switch r.op {
+	case opExists:
+		return ok
+	case opNotExists:
+		return !ok
+	case opEquals:
+		return ok && v == r.values[0]
+	case opNotEquals:
+		return !ok || v != r.values[0]
+	case opIn:
+		return ok && sliceContains(r.values, v)
+	default:
+		return !ok || !sliceContains(r.values, v)
+	}
+}
+
+// labelSelector is a parsed Kubernetes-style label selector, which
+// matches labels meeting all of its requirements. A nil labelSelector
+// matches everything.
+type labelSelector []labelRequirement
+
+// Matches reports whether the given labels match the selector
+func (sel labelSelector) Matches(labels map[string]string) bool {
+	for _, r := range sel {
+		if !r.matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseLabelSelector parses a comma-separated list of Kubernetes-style
+// label requirements, all of which must be met for labels to match:
+//
+//   - `key` / `!key`: the label is set / isn't set
+//   - `key=value` (or `key==value`): the label is set to value
+//   - `key!=value`: the label isn't set, or isn't set to value
+//   - `key in (v1,v2)`: the label is set to one of the values
+//   - `key notin (v1,v2)`: the label isn't set to any of the values
+//
+// An empty selector returns a nil labelSelector, matching everything.
+func parseLabelSelector(selector string) (labelSelector, error) {
+	p := &selectorParser{input: selector}
+	sel, err := p.parse()
+	if err != nil {
+		return nil, KQError{
+			Message: fmt.Sprintf("invalid selector: %s", err.Error()),
+			Code:    codes.InvalidArgument,
+		}
+	}
+	return sel, nil
+}
+
+// selectorParser parses a label selector for parseLabelSelector
+type selectorParser struct {
+	input string
+	pos   int
+}
+
+func (p *selectorParser) parse() (labelSelector, error) {
+	p.skipSpace()
+	if p.eof() {
+		return nil, nil
+	}
+
+	var sel labelSelector
+	for {
+		r, err := p.requirement()
+		if err != nil {
+			return nil, err
+		}
+		sel = append(sel, r)
+
+		p.skipSpace()
+		if p.eof() {
+			return sel, nil
+		}
+		if !p.consume(",") {
+			return nil, p.errorf("expected ','")
+		}
+	}
+}
+
+func (p *selectorParser) requirement() (labelRequirement, error) {
+	p.skipSpace()
+	if p.consume("!") {
+		p.skipSpace()
+		key, err := p.key()
+		return labelRequirement{key: key, op: opNotExists}, err
+	}
+
+	key, err := p.key()
+	if err != nil {
+		return labelRequirement{}, err
+	}
+	r := labelRequirement{key: key, op: opExists}
+
+	p.skipSpace()
+	switch {
+	case p.eof(), strings.HasPrefix(p.input[p.pos:], ","):
+		return r, nil
+	case p.consume("!="):
+		r.op = opNotEquals
+	case p.consume("=="), p.consume("="):
+		r.op = opEquals
+	default:
+		switch op := p.word(); op {
+		case "in":
+			r.op = opIn
+		case "notin":
+			r.op = opNotIn
+		default:
+			return r, p.errorf("unexpected '%s'", op)
+		}
+		r.values, err = p.valueSet()
+		return r, err
+	}
+
+	p.skipSpace()
+	value := p.word()
+	if err = validateLabelValue(value); err != nil {
+		return r, err
+	}
+	r.values = []string{value}
+	return r, nil
+}
+
+// key reads a label key
+func (p *selectorParser) key() (string, error) {
+	key := p.word()
+	if key == "" {
+		return "", p.errorf("expected label key")
+	}
+	return key, validateLabelKey(key)
+}
+
+// valueSet reads a parenthesized, comma-separated list of values
+func (p *selectorParser) valueSet() ([]string, error) {
+	p.skipSpace()
+	if !p.consume("(") {
+		return nil, p.errorf("expected '('")
+	}
+	var values []string
+	for {
+		p.skipSpace()
+		value := p.word()
+		if err := validateLabelValue(value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		p.skipSpace()
+		switch {
+		case p.consume(")"):
+			return values, nil
+		case !p.consume(","):
+			return nil, p.errorf("expected ',' or ')'")
+		}
+	}
+}
+
+// word reads a label key or value, up to the next whitespace or operator
+func (p *selectorParser) word() string {
+	start := p.pos
+	for !p.eof() && !strings.ContainsRune(" \t\n!=,()", rune(p.input[p.pos])) {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *selectorParser) consume(s string) bool {
+	if strings.HasPrefix(p.input[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *selectorParser) skipSpace() {
+	for !p.eof() && strings.ContainsRune(" \t\n", rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *selectorParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *selectorParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), p.pos)
+}
+
+// matchesSelector reports whether the key's labels match the given
+// selector. A nil selector matches every key.
+func (kv *keyValue) matchesSelector(sel labelSelector) bool {
+	if sel == nil {
+		return true
+	}
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	return sel.Matches(kv.Labels)
+}
+
+// keysMatchingSelector returns the (internal) keys in the given namespace
+// whose labels currently match the selector, for keyMatchesSelector
+func (s *Server) keysMatchingSelector(
+	namespace string,
+	sel labelSelector,
+) map[string]bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matched := make(map[string]bool)
+	for key, kvInfo := range s.store {
+		if keyNamespace, _ := splitNamespacedKey(key); keyNamespace != namespace {
+			continue
+		}
+		if kvInfo.matchesSelector(sel) {
+			matched[key] = true
+		}
+	}
+	return matched
+}
+
+// keyMatchesSelector reports whether the given key's current labels match
+// the selector, for filtering events. Once a key is gone (deleted, expired,
+// etc.) its labels are too, so it matches if it did when last checked.
+// matched tracks this, and is updated with the result.
+func (s *Server) keyMatchesSelector(
+	key string,
+	sel labelSelector,
+	matched map[string]bool,
+) bool {
+	s.mu.RLock()
+	kvInfo, exists := s.store[key]
+	s.mu.RUnlock()
+
+	if !exists {
+		m := matched[key]
+		delete(matched, key)
+		return m
+	}
+
+	m := kvInfo.matchesSelector(sel)
+	switch {
+	case m:
+		matched[key] = true
+	default:
+		delete(matched, key)
+	}
+	return m
+}
@@ -0,0 +1,284 @@
+package server
+
+import (
+	"errors"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"io"
+	"log/slog"
+	"time"
+)
+
+var (
+	ErrLeaseNotFound = KQError{
+		Message: "lease not found",
+		Code:    codes.NotFound,
+	}
+	ErrInvalidLeaseTTL = KQError{
+		Message: "invalid lease TTL",
+		Code:    codes.InvalidArgument,
+	}
+)
+
+// lease is granted to a client by Register, and expires after its TTL
+// unless renewed by KeepAlive. When it expires, keys set with the lease
+// are deleted, and locks acquired with it are released.
+type lease struct {
+	// ID is the unique identifier for the lease
+	ID string `json:"id"`
+	// ClientID is the client the lease was granted to
+	ClientID string `json:"client_id"`
+	// TTL is how long the lease lasts after being granted or renewed
+	TTL time.Duration `json:"ttl"`
+	// Keys are the keys set with the lease. A key is removed when it's
+	// deleted, or bound to another lease.
+	Keys map[string]struct{} `json:"keys,omitempty"`
+	// Locks are the keys locked with the lease. A key is removed when
+	// its lock is released, or bound to another lease.
+	Locks   map[string]struct{} `json:"locks,omitempty"`
+	expires time.Time
+	t       *time.Timer
+	srv     *Server
+}
+
+func (l *lease) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("id", l.ID),
+		slog.String(clientIDKey, l.ClientID),
+		slog.Duration("ttl", l.TTL),
+	)
+}
+
+// validateLeaseTTL returns the lease TTL, or ErrInvalidLeaseTTL if it's
+// outside the lock duration limits, as leases are used to hold locks
+func validateLeaseTTL(cfg Config, ttl *durationpb.Duration) (
+	time.Duration,
+	error,
+) {
+	d := ttl.AsDuration()
+	if d <= 0 || d < cfg.MinLockDuration || d > cfg.MaxLockDuration {
+		return 0, ErrInvalidLeaseTTL
+	}
+	return d, nil
+}
+
+// grantLease creates a new lease for the given client, and starts its
+// timer. Server.leaseMu must be held by the caller.
+func (s *Server) grantLease(clientID string, ttl time.Duration) *lease {
+	l := &lease{
+		ID:       uuid.NewString(),
+		ClientID: clientID,
+		TTL:      ttl,
+		Keys:     make(map[string]struct{}),
+		Locks:    make(map[string]struct{}),
+		srv:      s,
+	}
+	l.start(ttl)
+	s.leases[l.ID] = l
+	return l
+}
+
+// start (re)starts the lease's timer, to expire it after the given
+// duration. Server.leaseMu must be held by the caller.
+func (l *lease) start(d time.Duration) {
+	if l.t != nil {
+		_ = l.t.Stop()
+	}
+	l.expires = time.Now().Add(d)
+	l.t = time.AfterFunc(d, l.ExpireFunc())
+}
+
+// ExpireFunc returns a function to be used with time.AfterFunc to expire
+// the lease, deleting its keys and releasing its locks. If the lease was
+// renewed after the timer fired, nothing happens.
+func (l *lease) ExpireFunc() func() {
+	return func() {
+		srv := l.srv
+		srv.mu.Lock()
+		defer srv.mu.Unlock()
+
+		if srv.stopped {
+			return
+		}
+
+		srv.lockMu.Lock()
+		defer srv.lockMu.Unlock()
+
+		srv.reaperMu.Lock()
+		defer srv.reaperMu.Unlock()
+
+		srv.leaseMu.Lock()
+		if current, ok := srv.leases[l.ID]; !ok || current != l ||
+			time.Now().Before(l.expires) {
+			srv.leaseMu.Unlock()
+			return
+		}
+		delete(srv.leases, l.ID)
+		// Once it's removed, nothing else can bind to the lease, and
+		// unbinding from it (as its keys and locks are released below)
+		// does nothing
+		srv.leaseMu.Unlock()
+
+		logger := srv.logger.With(loggerKey, "lease", "lease", l)
+		logger.Info("lease expired")
+
+		for key := range l.Locks {
+			if keyLock, ok := srv.locks[key]; ok && keyLock.LeaseID == l.ID {
+				logger.Debug("releasing lock", "key", key)
+				srv.releaseLock(keyLock, l.ClientID)
+			}
+			if keyLock, ok := srv.sharedLocks[key][l.ClientID]; ok &&
+				keyLock.LeaseID == l.ID {
+				logger.Debug("releasing shared lock", "key", key)
+				srv.removeSharedLock(key, l.ClientID)
+				now := time.Now()
+				srv.emit(key, Unlocked, l.ClientID, &now)
+			}
+		}
+
+		for key := range l.Keys {
+			kvInfo, ok := srv.store[key]
+			if !ok {
+				continue
+			}
+			kvInfo.mu.Lock()
+			if kvInfo.LeaseID == l.ID {
+				logger.Debug("deleting key", "key", key)
+				srv.deleteKey(key, l.ClientID, Expired)
+			}
+			kvInfo.mu.Unlock()
+		}
+	}
+}
+
+// checkLease returns ErrLeaseNotFound if the given lease ID was provided,
+// but it doesn't exist (or has expired), or it belongs to another client.
+// Server.leaseMu must not be held by the caller.
+func (s *Server) checkLease(leaseID string, clientID string) error {
+	if leaseID == "" {
+		return nil
+	}
+	s.leaseMu.RLock()
+	defer s.leaseMu.RUnlock()
+	if l, ok := s.leases[leaseID]; !ok || l.ClientID != clientID {
+		return ErrLeaseNotFound
+	}
+	return nil
+}
+
+// bindLease adds the given key to the lease's keys (or, if lock is true,
+// its locks), if it still exists. Server.leaseMu must not be held by the
+// caller.
+func (s *Server) bindLease(leaseID string, key string, lock bool) {
+	if leaseID == "" {
+		return
+	}
+	s.leaseMu.Lock()
+	defer s.leaseMu.Unlock()
+	l, ok := s.leases[leaseID]
+	if !ok {
+		return
+	}
+	switch {
+	case lock:
+		l.Locks[key] = struct{}{}
+	default:
+		l.Keys[key] = struct{}{}
+	}
+}
+
+// unbindLease removes the given key from the lease's keys (or, if lock
+// is true, its locks), if it still exists. Server.leaseMu must not be
+// held by the caller.
+func (s *Server) unbindLease(leaseID string, key string, lock bool) {
+	if leaseID == "" {
+		return
+	}
+	s.leaseMu.Lock()
+	defer s.leaseMu.Unlock()
+	l, ok := s.leases[leaseID]
+	if !ok {
+		return
+	}
+	switch {
+	case lock:
+		delete(l.Locks, key)
+	default:
+		delete(l.Keys, key)
+	}
+}
+
+// bindLockLease binds the given lock to a lease, in place of the lease
+// it was previously acquired with (if any). Server.lockMu must be held,
+// and Server.leaseMu must not be held, by the caller.
+func (s *Server) bindLockLease(keyLock *kvLock, leaseID string) {
+	if leaseID == "" {
+		return
+	}
+	if keyLock.LeaseID != leaseID {
+		s.unbindLease(keyLock.LeaseID, keyLock.Key, true)
+		keyLock.LeaseID = leaseID
+	}
+	s.bindLease(leaseID, keyLock.Key, true)
+}
+
+// KeepAlive renews the lease in each request received on the stream,
+// sending the lease's new expiration time. If the lease doesn't exist
+// (or has already expired), or belongs to another client, ErrLeaseNotFound
+// is returned and the stream is closed.
+func (s *Server) KeepAlive(stream pb.KeyQuarry_KeepAliveServer) error {
+	ctx := stream.Context()
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info("keepalive stream started")
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			logger.Info("keepalive stream closed")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		s.leaseMu.Lock()
+		l, ok := s.leases[req.LeaseId]
+		if !ok || l.ClientID != clientID {
+			s.leaseMu.Unlock()
+			logger.Info("lease not found", "lease_id", req.LeaseId)
+			return ErrLeaseNotFound
+		}
+		l.start(l.TTL)
+		resp := &pb.KeepAliveResponse{
+			LeaseId: l.ID,
+			Ttl:     durationpb.New(l.TTL),
+			Expires: timestamppb.New(l.expires),
+		}
+		s.leaseMu.Unlock()
+		logger.Debug("renewed lease", "lease_id", req.LeaseId)
+
+		if err = stream.Send(resp); err != nil {
+			logger.Error("error sending keepalive response", "error", err)
+			return err
+		}
+	}
+}
+
+// loadLease restores a lease from a snapshot, restarting its timer with
+// its full TTL, so clients have a chance to reconnect and renew it.
+// Server.leaseMu must be held by the caller.
+func (s *Server) loadLease(l *lease) {
+	l.srv = s
+	if l.Keys == nil {
+		l.Keys = make(map[string]struct{})
+	}
+	if l.Locks == nil {
+		l.Locks = make(map[string]struct{})
+	}
+	l.start(l.TTL)
+	s.leases[l.ID] = l
+}
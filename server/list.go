@@ -0,0 +1,407 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+var (
+	ErrNoListValues = KQError{
+		Message: "at least one value is required",
+		Code:    codes.InvalidArgument,
+	}
+	ErrListEmpty = KQError{
+		Message: "list is empty",
+		Code:    codes.NotFound,
+	}
+	ErrInvalidBlockTimeout = KQError{
+		Message: "block_timeout must be greater than 0",
+		Code:    codes.InvalidArgument,
+	}
+	ErrListPopTimeout = KQError{
+		Message: "timed out waiting for a value to be pushed",
+		Code:    codes.DeadlineExceeded,
+	}
+)
+
+// listWaiter is a ListPop request waiting in a key's queue (in
+// Server.listWaiters) for a value to be pushed to the list
+type listWaiter struct {
+	// ready is signaled when values have been pushed to the list, so it
+	// should try to pop a value again
+	ready chan struct{}
+}
+
+// cloneValues returns a deep copy of the given values
+func cloneValues(values [][]byte) [][]byte {
+	if values == nil {
+		return nil
+	}
+	rv := make([][]byte, len(values))
+	for i, v := range values {
+		rv[i] = make([]byte, len(v))
+		copy(rv[i], v)
+	}
+	return rv
+}
+
+// ListPush pushes the given values to the start or end of a list,
+// creating the key if it doesn't exist. The total size of the values in
+// the list is limited by [Config.MaxValueSize].
+func (s *Server) ListPush(ctx context.Context, in *pb.ListPushRequest) (
+	*pb.ListPushResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request to push to list",
+		slog.String("key", in.Key),
+		slog.Int("values", len(in.Values)),
+		slog.String("end", in.End.String()),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if _, _, err := validateSetRequest(cfg, &pb.KeyValue{Key: in.Key}); err != nil {
+		return nil, err
+	}
+	if len(in.Values) == 0 {
+		return nil, ErrNoListValues
+	}
+	var added uint64
+	for _, v := range in.Values {
+		added += uint64(len(v))
+	}
+	if cfg.MaxValueSize > 0 && added > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kvInfo, isNew, err := s.typedKeyForWrite(
+		cfg,
+		clientID,
+		key,
+		pb.ValueType_LIST,
+		true,
+	)
+	if err != nil {
+		logger.Info("unable to push to list", "error", err)
+		return nil, err
+	}
+
+	kvInfo.mu.Lock()
+	defer kvInfo.mu.Unlock()
+
+	size := kvInfo.Size + added
+	if cfg.MaxValueSize > 0 && size > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+
+	values := make([][]byte, 0, len(in.Values))
+	for _, v := range in.Values {
+		value := make([]byte, len(v))
+		copy(value, v)
+		values = append(values, kvInfo.encodeElement(value, s.valueKeys))
+	}
+	switch in.End {
+	case pb.ListEnd_RIGHT:
+		kvInfo.List = append(kvInfo.List, values...)
+	default:
+		// Values are pushed onto the start one at a time, so they end
+		// up in reverse order
+		slices.Reverse(values)
+		kvInfo.List = append(values, kvInfo.List...)
+	}
+	s.updateValue(kvInfo, clientID, size, isNew)
+	s.notifyListWaiters(key, len(in.Values))
+
+	return &pb.ListPushResponse{
+		Length:  uint64(len(kvInfo.List)),
+		Version: kvInfo.Version,
+	}, nil
+}
+
+// ListPop removes and returns the value at the start or end of a list.
+// If the list is empty (or doesn't exist), and a block timeout was
+// provided, the request waits up to that long for a value to be pushed.
+// Otherwise, ErrListEmpty (or ErrKeyNotFound) is returned.
+func (s *Server) ListPop(ctx context.Context, in *pb.ListPopRequest) (
+	*pb.ListPopResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request to pop from list",
+		slog.String("key", in.Key),
+		slog.String("end", in.End.String()),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if _, _, err := validateSetRequest(cfg, &pb.KeyValue{Key: in.Key}); err != nil {
+		return nil, err
+	}
+	var blockTimeout time.Duration
+	if in.BlockTimeout != nil {
+		blockTimeout = in.BlockTimeout.AsDuration()
+		if blockTimeout <= 0 {
+			return nil, ErrInvalidBlockTimeout
+		}
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.Lock()
+	rv, err := s.popList(cfg, clientID, key, in.End)
+	if blockTimeout == 0 || status.Code(err) != codes.NotFound {
+		s.mu.Unlock()
+		return rv, err
+	}
+	w := s.enqueueListWaiter(key)
+	s.mu.Unlock()
+
+	logger.Info("waiting for list value", "block_timeout", blockTimeout)
+	timer := time.NewTimer(blockTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("list pop cancelled while waiting")
+			s.cancelListWait(key, w)
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+			logger.Info("timed out waiting for list value")
+			s.cancelListWait(key, w)
+			return nil, ErrListPopTimeout
+		case <-w.ready:
+		}
+
+		s.mu.Lock()
+		rv, err = s.popList(cfg, clientID, key, in.End)
+		if status.Code(err) == codes.NotFound {
+			// Another client got there first, so wait for the next push
+			s.mu.Unlock()
+			continue
+		}
+		s.removeListWaiter(key, w)
+		s.mu.Unlock()
+		return rv, err
+	}
+}
+
+// popList removes and returns the value at the given end of a list.
+// Server.mu must be held by the caller.
+func (s *Server) popList(
+	cfg Config,
+	clientID string,
+	key string,
+	end pb.ListEnd,
+) (*pb.ListPopResponse, error) {
+	kvInfo, _, err := s.typedKeyForWrite(
+		cfg,
+		clientID,
+		key,
+		pb.ValueType_LIST,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	kvInfo.mu.Lock()
+	defer kvInfo.mu.Unlock()
+
+	n := len(kvInfo.List)
+	if n == 0 {
+		return nil, ErrListEmpty
+	}
+	i := 0
+	if end == pb.ListEnd_RIGHT {
+		i = n - 1
+	}
+	value, err := kvInfo.rawElement(kvInfo.List[i], s.keys)
+	if err != nil {
+		return nil, err
+	}
+	switch end {
+	case pb.ListEnd_RIGHT:
+		kvInfo.List[n-1] = nil
+		kvInfo.List = kvInfo.List[:n-1]
+	default:
+		kvInfo.List[0] = nil
+		kvInfo.List = kvInfo.List[1:]
+	}
+	s.updateValue(kvInfo, clientID, kvInfo.Size-uint64(len(value)), false)
+	return &pb.ListPopResponse{
+		Value:  value,
+		Length: uint64(len(kvInfo.List)),
+	}, nil
+}
+
+// ListRange returns the values of a list between the start and stop
+// indexes (inclusive). Negative indexes count back from the end of the
+// list. Indexes past the end of the list are ignored.
+func (s *Server) ListRange(ctx context.Context, in *pb.ListRangeRequest) (
+	*pb.ListRangeResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request for list range",
+		slog.String("key", in.Key),
+		slog.Int64("start", in.Start),
+		slog.Int64("stop", in.Stop),
+	)
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	kvInfo, err := s.typedKeyForRead(clientID, key, pb.ValueType_LIST)
+	if err != nil {
+		return nil, err
+	}
+
+	kvInfo.mu.RLock()
+	defer kvInfo.mu.RUnlock()
+
+	n := int64(len(kvInfo.List))
+	start, stop := in.Start, in.Stop
+	if start < 0 {
+		start = max(n+start, 0)
+	}
+	if stop < 0 {
+		stop = n + stop
+	}
+	stop = min(stop, n-1)
+
+	resp := &pb.ListRangeResponse{}
+	if start > stop {
+		return resp, nil
+	}
+	resp.Values = make([][]byte, 0, stop+1-start)
+	for _, v := range kvInfo.List[start : stop+1] {
+		value, err := kvInfo.rawElement(v, s.keys)
+		if err != nil {
+			return nil, err
+		}
+		resp.Values = append(resp.Values, bytes.Clone(value))
+	}
+
+	t := time.Now()
+	s.emit(key, Accessed, clientID, &t)
+	return resp, nil
+}
+
+// ListLen returns the number of values in a list
+func (s *Server) ListLen(ctx context.Context, in *pb.Key) (
+	*pb.ListLenResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	logger.Debug("request for list length", slog.String("key", in.Key))
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	kvInfo, err := s.typedKeyForRead(s.ClientID(ctx), key, pb.ValueType_LIST)
+	if err != nil {
+		return nil, err
+	}
+	kvInfo.mu.RLock()
+	defer kvInfo.mu.RUnlock()
+	return &pb.ListLenResponse{Length: uint64(len(kvInfo.List))}, nil
+}
+
+// enqueueListWaiter adds a waiter to the end of the key's queue.
+// Server.mu must be held by the caller.
+func (s *Server) enqueueListWaiter(key string) *listWaiter {
+	w := &listWaiter{ready: make(chan struct{}, 1)}
+	s.listWaiters[key] = append(s.listWaiters[key], w)
+	return w
+}
+
+// cancelListWait removes the given waiter from the key's queue
+func (s *Server) cancelListWait(key string, w *listWaiter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeListWaiter(key, w)
+}
+
+// removeListWaiter removes the given waiter from the key's queue, if
+// it's still queued. If values remain in the list, the waiters now at
+// the front of the queue are notified, in case the removed waiter had
+// been notified but didn't pop a value. Server.mu must be held by the
+// caller.
+func (s *Server) removeListWaiter(key string, w *listWaiter) {
+	waiters := s.listWaiters[key]
+	for i, waiter := range waiters {
+		if waiter != w {
+			continue
+		}
+		waiters = append(waiters[:i], waiters[i+1:]...)
+		if len(waiters) == 0 {
+			delete(s.listWaiters, key)
+			return
+		}
+		s.listWaiters[key] = waiters
+		break
+	}
+	if kvInfo, ok := s.store[key]; ok && kvInfo.Type == pb.ValueType_LIST {
+		s.notifyListWaiters(key, len(kvInfo.List))
+	}
+}
+
+// notifyListWaiters signals up to n waiters at the front of the key's
+// queue to try to pop a value. Server.mu must be held by the caller.
+func (s *Server) notifyListWaiters(key string, n int) {
+	for i, w := range s.listWaiters[key] {
+		if i >= n {
+			return
+		}
+		select {
+		case w.ready <- struct{}{}:
+		default:
+			// already signaled
+		}
+	}
+}
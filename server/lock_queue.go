@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"time"
+)
+
+// lockWaiter is a Lock request waiting in a key's queue (in
+// Server.lockWaiters) for another client to release its lock
+type lockWaiter struct {
+	clientID string
+
+	// ready is signaled when the waiter is at the front of the queue
+	// and the key's locks have changed, so it should try to acquire
+	// the lock again
+	ready chan struct{}
+}
+
+// waitForLock queues the given request, and waits until it's granted the
+// lock, the wait timeout passes, or the context is cancelled. A wait
+// timeout of 0 waits until the context is cancelled. Requests are tried
+// in the order they were queued, each time a lock on the key is released.
+// If onGranted isn't nil, it's called when the lock is granted, while
+// Server.mu is still held.
+func (s *Server) waitForLock(
+	ctx context.Context,
+	logger *slog.Logger,
+	cfg Config,
+	clientID string,
+	in *pb.LockRequest,
+	lockDuration time.Duration,
+	waitTimeout time.Duration,
+	w *lockWaiter,
+	onGranted func() error,
+) (*pb.LockResponse, error) {
+	logger.Info(
+		"waiting for lock",
+		slog.String("key", in.Key),
+		slog.Duration("wait_timeout", waitTimeout),
+	)
+	var timeout <-chan time.Time
+	if waitTimeout > 0 {
+		timer := time.NewTimer(waitTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("lock request cancelled while waiting")
+			s.cancelLockWait(in.Key, w)
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-timeout:
+			logger.Info("timed out waiting for lock")
+			s.cancelLockWait(in.Key, w)
+			return nil, ErrLockWaitTimeout
+		case <-w.ready:
+		}
+
+		s.mu.Lock()
+		rv, err := s.lockKey(logger, cfg, clientID, in, lockDuration)
+		if status.Code(err) == codes.PermissionDenied {
+			// Still held by another client, so wait for the next change
+			s.mu.Unlock()
+			continue
+		}
+		s.lockMu.Lock()
+		s.removeLockWaiter(in.Key, w)
+		s.lockMu.Unlock()
+		if err == nil && onGranted != nil {
+			err = onGranted()
+		}
+		s.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		return rv, nil
+	}
+}
+
+// cancelLockWait removes the given waiter from the key's queue
+func (s *Server) cancelLockWait(key string, w *lockWaiter) {
+	s.lockMu.Lock()
+	defer s.lockMu.Unlock()
+	s.removeLockWaiter(key, w)
+}
+
+// enqueueLockWaiter adds a waiter for the given client to the end of
+// the key's queue. Server.lockMu must be held by the caller.
+func (s *Server) enqueueLockWaiter(key string, clientID string) *lockWaiter {
+	w := &lockWaiter{clientID: clientID, ready: make(chan struct{}, 1)}
+	s.lockWaiters[key] = append(s.lockWaiters[key], w)
+	s.numLockWaiters.Add(1)
+	return w
+}
+
+// removeLockWaiter removes the given waiter from the key's queue, if it's
+// still queued. If it was at the front of the queue, the next waiter is
+// notified. Server.lockMu must be held by the caller.
+func (s *Server) removeLockWaiter(key string, w *lockWaiter) {
+	waiters := s.lockWaiters[key]
+	for i, waiter := range waiters {
+		if waiter != w {
+			continue
+		}
+		waiters = append(waiters[:i], waiters[i+1:]...)
+		s.numLockWaiters.Add(decrementUint64)
+		if len(waiters) == 0 {
+			delete(s.lockWaiters, key)
+			return
+		}
+		s.lockWaiters[key] = waiters
+		if i == 0 {
+			s.notifyLockWaiter(key)
+		}
+		return
+	}
+}
+
+// notifyLockWaiter signals the waiter at the front of the key's queue,
+// if any, to try to acquire the lock. This should be called whenever a
+// lock on the key is released. Server.lockMu must be held by the caller.
+func (s *Server) notifyLockWaiter(key string) {
+	waiters := s.lockWaiters[key]
+	if len(waiters) == 0 {
+		return
+	}
+	select {
+	case waiters[0].ready <- struct{}{}:
+	default:
+		// already signaled
+	}
+}
+
+// lockQueuedAhead returns true if another client is at the front of the
+// key's queue, and the given client doesn't already hold a lock on the
+// key, in which case the client has to wait its turn. Server.lockMu must
+// be held by the caller.
+func (s *Server) lockQueuedAhead(key string, clientID string) bool {
+	waiters := s.lockWaiters[key]
+	if len(waiters) == 0 || waiters[0].clientID == clientID {
+		return false
+	}
+	if keyLock, ok := s.locks[key]; ok && keyLock.ClientID == clientID {
+		return false
+	}
+	if _, ok := s.sharedLocks[key][clientID]; ok {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,297 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"regexp"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	// namespaceKey is the request metadata key used to specify
+	// the namespace for a request
+	namespaceKey = "namespace"
+
+	// namespaceSeparator marks the start and end of the namespace
+	// in the internal key for a namespaced key. This is the ASCII unit
+	// separator, rather than NUL, which can't be stored in a JSONB
+	// snapshot column.
+	namespaceSeparator = "\x1f"
+
+	// maxNamespaceLength is the maximum length of a namespace name
+	maxNamespaceLength = 128
+)
+
+var namespacePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+var ErrInvalidNamespace = KQError{
+	Message: fmt.Sprintf(
+		"invalid namespace (must match %s, max length %d)",
+		namespacePattern.String(),
+		maxNamespaceLength,
+	),
+	Code: codes.InvalidArgument,
+}
+
+// NamespaceConfig overrides the server's Config for keys in a
+// specific namespace. Unset (nil) values use the server's Config.
+type NamespaceConfig struct {
+	// MaxNumberOfKeys limits the number of keys in the namespace. This is
+	// checked in addition to Config.MaxNumberOfKeys, which limits the number
+	// of keys across all namespaces. Set to 0 for no limit.
+	MaxNumberOfKeys *uint64 `json:"max_keys,omitempty" yaml:"max_keys,omitempty" mapstructure:"max_keys"`
+
+	// MaxValueSize is the maximum size of a value in bytes, for keys
+	// in the namespace
+	MaxValueSize *uint64 `json:"max_value_size,omitempty" yaml:"max_value_size,omitempty" mapstructure:"max_value_size"`
+
+	// RevisionLimit is the maximum number of revisions to keep for each
+	// key in the namespace
+	RevisionLimit *int64 `json:"revision_limit,omitempty" yaml:"revision_limit,omitempty" mapstructure:"revision_limit"`
+}
+
+// namespaceMetrics tracks the number of keys, and their total size,
+// in a namespace
+type namespaceMetrics struct {
+	numKeys   atomic.Uint64
+	totalSize atomic.Uint64
+}
+
+// validateNamespace returns ErrInvalidNamespace if the given (non-default)
+// namespace name isn't valid
+func validateNamespace(namespace string) error {
+	if len(namespace) > maxNamespaceLength ||
+		!namespacePattern.MatchString(namespace) {
+		return ErrInvalidNamespace
+	}
+	return nil
+}
+
+// validateNamespaceConfigs returns an error for the first namespace
+// in the given overrides which isn't valid. The default namespace can't
+// be overridden, as the server's Config already applies to it, and keys
+// aren't counted per namespace there.
+func validateNamespaceConfigs(namespaces map[string]NamespaceConfig) error {
+	for namespace := range namespaces {
+		if namespace == "" {
+			return errors.New(
+				"namespaces: the default namespace can't be overridden, use the server options instead",
+			)
+		}
+		if err := validateNamespace(namespace); err != nil {
+			return fmt.Errorf("namespace '%s': %w", namespace, err)
+		}
+	}
+	return nil
+}
+
+// namespacedKey returns the internal key for the given key in the given
+// namespace. Keys in the default namespace ("") are stored as-is, unless
+// they begin with namespaceSeparator. Otherwise, the internal key is
+// the namespace and key, each preceded by namespaceSeparator, so a key
+// in one namespace can't be addressed from another.
+func namespacedKey(namespace string, key string) string {
+	if namespace == "" && !strings.HasPrefix(key, namespaceSeparator) {
+		return key
+	}
+	return namespaceSeparator + namespace + namespaceSeparator + key
+}
+
+// splitNamespacedKey returns the namespace and key for the given
+// internal key. This is the inverse of namespacedKey.
+func splitNamespacedKey(key string) (namespace string, name string) {
+	rest, found := strings.CutPrefix(key, namespaceSeparator)
+	if !found {
+		return "", key
+	}
+	namespace, name, _ = strings.Cut(rest, namespaceSeparator)
+	return namespace, name
+}
+
+// keyName returns the given internal key without its namespace
+func keyName(key string) string {
+	_, name := splitNamespacedKey(key)
+	return name
+}
+
+// NamespaceFromContext returns the namespace from the given context's
+// request metadata. If no namespace was provided, the default namespace
+// ("") is returned.
+func (s *Server) NamespaceFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+	namespaces := md[namespaceKey]
+	if len(namespaces) == 0 || namespaces[0] == "" {
+		return "", nil
+	}
+
+	namespace := namespaces[0]
+	if err := validateNamespace(namespace); err != nil {
+		return "", err
+	}
+
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("namespace", namespace))
+	return namespace, nil
+}
+
+// Namespace returns the namespace from the given context. Requests
+// with an invalid namespace are rejected by NamespaceInterceptor and
+// NamespaceStreamInterceptor, so for those, this returns the default
+// namespace.
+func (s *Server) Namespace(ctx context.Context) string {
+	namespace, _ := s.NamespaceFromContext(ctx)
+	return namespace
+}
+
+// namespacedKey returns the internal key for the given key, in the
+// namespace from the given context
+func (s *Server) namespacedKey(ctx context.Context, key string) string {
+	return namespacedKey(s.Namespace(ctx), key)
+}
+
+// NamespaceInterceptor rejects unary requests with an invalid namespace
+func NamespaceInterceptor(srv *Server) grpc.UnaryServerInterceptor {
+	f := func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (resp any, err error) {
+		if _, err = srv.NamespaceFromContext(ctx); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	return f
+}
+
+// NamespaceStreamInterceptor rejects streaming requests with an
+// invalid namespace
+func NamespaceStreamInterceptor(srv *Server) grpc.StreamServerInterceptor {
+	f := func(
+		s any,
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if _, err := srv.NamespaceFromContext(ss.Context()); err != nil {
+			return err
+		}
+		return handler(s, ss)
+	}
+	return f
+}
+
+// forNamespace returns a copy of the Config with the MaxValueSize and
+// RevisionLimit overrides for the given namespace applied, if any
+func (c Config) forNamespace(namespace string) Config {
+	nsCfg, ok := c.Namespaces[namespace]
+	if !ok {
+		return c
+	}
+	if nsCfg.MaxValueSize != nil {
+		c.MaxValueSize = *nsCfg.MaxValueSize
+	}
+	if nsCfg.RevisionLimit != nil {
+		c.RevisionLimit = *nsCfg.RevisionLimit
+	}
+	return c
+}
+
+// checkNamespaceMaxKeys returns ErrMaxKeysReached if adding newKeys
+// keys to the given namespace would exceed its
+// NamespaceConfig.MaxNumberOfKeys
+func (s *Server) checkNamespaceMaxKeys(
+	cfg Config,
+	namespace string,
+	newKeys uint64,
+) error {
+	nsCfg, ok := cfg.Namespaces[namespace]
+	if !ok || nsCfg.MaxNumberOfKeys == nil || *nsCfg.MaxNumberOfKeys == 0 {
+		return nil
+	}
+	var currentCt uint64
+	if m := s.getNamespaceMetrics(namespace, false); m != nil {
+		currentCt = m.numKeys.Load()
+	}
+	if currentCt+newKeys > *nsCfg.MaxNumberOfKeys {
+		s.logger.Warn(
+			"number of keys in namespace >= maximum",
+			"namespace", namespace,
+			"current", currentCt,
+			"max", *nsCfg.MaxNumberOfKeys,
+		)
+		return ErrMaxKeysReached
+	}
+	return nil
+}
+
+// getNamespaceMetrics returns the namespaceMetrics for the given
+// namespace. If create is true, it will be created if it doesn't exist.
+// Otherwise, nil is returned.
+func (s *Server) getNamespaceMetrics(
+	namespace string,
+	create bool,
+) *namespaceMetrics {
+	s.nsMu.RLock()
+	m, ok := s.namespaces[namespace]
+	s.nsMu.RUnlock()
+	if ok || !create {
+		return m
+	}
+
+	s.nsMu.Lock()
+	defer s.nsMu.Unlock()
+	m, ok = s.namespaces[namespace]
+	if !ok {
+		m = &namespaceMetrics{}
+		s.namespaces[namespace] = m
+	}
+	return m
+}
+
+// addNamespaceKey updates the metrics for the namespace of the
+// given (internal) key, for a key of the given size being added
+func (s *Server) addNamespaceKey(key string, size uint64) {
+	namespace, _ := splitNamespacedKey(key)
+	if namespace == "" {
+		return
+	}
+	m := s.getNamespaceMetrics(namespace, true)
+	m.numKeys.Add(1)
+	m.totalSize.Add(size)
+}
+
+// removeNamespaceKey updates the metrics for the namespace of the
+// given (internal) key, for a key of the given size being removed
+func (s *Server) removeNamespaceKey(key string, size uint64) {
+	namespace, _ := splitNamespacedKey(key)
+	if namespace == "" {
+		return
+	}
+	m := s.getNamespaceMetrics(namespace, true)
+	m.numKeys.Add(decrementUint64)
+	m.totalSize.Add(^(size - 1))
+}
+
+// resizeNamespaceKey updates the metrics for the namespace of the
+// given (internal) key, for a key's value changing size
+func (s *Server) resizeNamespaceKey(key string, oldSize, newSize uint64) {
+	namespace, _ := splitNamespacedKey(key)
+	if namespace == "" {
+		return
+	}
+	m := s.getNamespaceMetrics(namespace, true)
+	m.totalSize.Add(^(oldSize - 1))
+	m.totalSize.Add(newSize)
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"time"
+)
+
+// Persist removes the lifespan of a key, if it has one, so it won't
+// expire. The value of the key isn't changed. A [LifespanCleared] event
+// is emitted if a lifespan was removed. As with Set, ErrLocked or
+// ErrSharedLocked is returned if another client holds a lock on the key.
+func (s *Server) Persist(ctx context.Context, in *pb.PersistRequest) (
+	*pb.PersistResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	logger.Info("request to persist key", slog.String("key", in.Key))
+	clientID := s.ClientID(ctx)
+
+	s.cfgMu.RLock()
+	readonly := s.cfg.Readonly
+	s.cfgMu.RUnlock()
+	if readonly {
+		return nil, ErrReadOnlyServer
+	}
+	in.Key = s.namespacedKey(ctx, in.Key)
+
+	// The store itself isn't modified, and the reaper can't expire the
+	// key while this is held
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.getKey(in.Key) == nil {
+		return nil, ErrKeyNotFound
+	}
+
+	s.lockMu.RLock()
+	keyLock, locked := s.locks[in.Key]
+	sharedLocked := s.sharedLockedByOthers(in.Key, clientID)
+	s.lockMu.RUnlock()
+	switch {
+	case locked && keyLock.ClientID != clientID:
+		logger.Info("cannot persist key locked by another client")
+		return nil, ErrLocked
+	case sharedLocked:
+		logger.Info("cannot persist key with shared locks held by other clients")
+		return nil, ErrSharedLocked
+	}
+
+	s.reaperMu.Lock()
+	defer s.reaperMu.Unlock()
+
+	keyReaper, ok := s.reapers[in.Key]
+	if !ok {
+		return &pb.PersistResponse{Persisted: false}, nil
+	}
+	if keyReaper.t != nil {
+		_ = keyReaper.t.Stop()
+	}
+	delete(s.reapers, in.Key)
+	s.numReapers.Add(decrementUint64)
+
+	now := time.Now()
+	s.emit(in.Key, LifespanCleared, clientID, &now)
+	logger.Info("removed key lifespan", "reaper", keyReaper)
+	return &pb.PersistResponse{Persisted: true}, nil
+}
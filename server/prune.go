@@ -91,8 +91,37 @@ func (p *pruner) Prune(
 	ctx context.Context,
 	targetCount uint64,
 	ignoreKey ...string,
+) []string {
+	return p.prune(ctx, nil, targetCount, ignoreKey...)
+}
+
+// PruneNamespace is like Prune, but only prunes keys in the given
+// namespace, until the number of keys in the namespace reaches
+// targetCount. ignoreKey should be internal (namespaced) keys.
+func (p *pruner) PruneNamespace(
+	ctx context.Context,
+	namespace string,
+	targetCount uint64,
+	ignoreKey ...string,
+) []string {
+	return p.prune(ctx, &namespace, targetCount, ignoreKey...)
+}
+
+// prune deletes unlocked keys until targetCount is reached. If namespace
+// is set, only keys in that namespace are counted and pruned.
+func (p *pruner) prune(
+	ctx context.Context,
+	namespace *string,
+	targetCount uint64,
+	ignoreKey ...string,
 ) []string {
 	reportedCt := p.srv.numKeys.Load()
+	if namespace != nil {
+		reportedCt = 0
+		if m := p.srv.getNamespaceMetrics(*namespace, false); m != nil {
+			reportedCt = m.numKeys.Load()
+		}
+	}
 	if reportedCt < targetCount {
 		p.logger.Info(
 			"reported key count is below target",
@@ -128,6 +157,12 @@ func (p *pruner) Prune(
 	for lockedKey := range p.srv.locks {
 		ignoreKey = append(ignoreKey, lockedKey)
 	}
+	for lockedKey := range p.srv.sharedLocks {
+		ignoreKey = append(ignoreKey, lockedKey)
+	}
+	for semaphoreKey := range p.srv.semaphores {
+		ignoreKey = append(ignoreKey, semaphoreKey)
+	}
 
 	defer p.srv.numPruneCompleted.Add(1)
 
@@ -137,7 +172,7 @@ func (p *pruner) Prune(
 		targetCount,
 	)
 
-	toRemove := p.srv.staleKeys(ctx, ignoreKey...)
+	toRemove := p.srv.staleKeys(ctx, namespace, ignoreKey...)
 	removeLimit := reportedCt - targetCount
 	if int(removeLimit) > len(toRemove) {
 		removeLimit = uint64(len(toRemove))
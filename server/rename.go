@@ -0,0 +1,400 @@
+package server
+
+import (
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"log/slog"
+	"maps"
+	"strings"
+	"time"
+)
+
+// Rename moves the value, content type, labels and lifespan of a key (and,
+// if requested, its revision history) to a new key, then deletes the
+// original key, releasing any lock this client held on it. The
+// destination is written in place if it exists and overwrite is set,
+// otherwise an error is returned. If either key is locked by another
+// client, an error is returned.
+func (s *Server) Rename(ctx context.Context, in *pb.RenameRequest) (
+	*pb.RenameResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("source", in.Source),
+		attribute.String("destination", in.Destination),
+	)
+
+	logger := s.requestLogger(ctx)
+	logger.Info(
+		"request to rename key",
+		slog.Group(
+			"request",
+			slog.String("source", in.Source),
+			slog.String("destination", in.Destination),
+			slog.Bool("overwrite", in.Overwrite),
+			slog.Bool("include_history", in.IncludeHistory),
+		),
+	)
+
+	version, err := s.copyKey(
+		ctx,
+		logger,
+		in.Source,
+		in.Destination,
+		in.Overwrite,
+		in.IncludeHistory,
+		true,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RenameResponse{Success: true, Version: version}, nil
+}
+
+// Copy duplicates the value, content type, labels and lifespan of a key (and,
+// if requested, its revision history) to a new key, with the same
+// conditions as Rename. The copy expires at the same time as the
+// original key.
+func (s *Server) Copy(ctx context.Context, in *pb.CopyRequest) (
+	*pb.CopyResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("source", in.Source),
+		attribute.String("destination", in.Destination),
+	)
+
+	logger := s.requestLogger(ctx)
+	logger.Info(
+		"request to copy key",
+		slog.Group(
+			"request",
+			slog.String("source", in.Source),
+			slog.String("destination", in.Destination),
+			slog.Bool("overwrite", in.Overwrite),
+			slog.Bool("include_history", in.IncludeHistory),
+		),
+	)
+
+	version, err := s.copyKey(
+		ctx,
+		logger,
+		in.Source,
+		in.Destination,
+		in.Overwrite,
+		in.IncludeHistory,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CopyResponse{Success: true, Version: version}, nil
+}
+
+// validateCopyRequest returns an error if the given source and
+// destination keys can't be used with Rename or Copy, regardless of
+// the current state of either key
+func validateCopyRequest(cfg Config, source string, destination string) error {
+	if source == "" || destination == "" {
+		return ErrEmptyKey
+	}
+	if strings.HasPrefix(strings.ToLower(source), ReservedKeyPrefix) ||
+		strings.HasPrefix(strings.ToLower(destination), ReservedKeyPrefix) {
+		return ErrReservedKeyPrefix
+	}
+	if source == destination {
+		return ErrSameSourceAndDestination
+	}
+	if cfg.MaxKeyLength > 0 && uint64(len(destination)) > cfg.MaxKeyLength {
+		return ErrKeyTooLong
+	}
+	return nil
+}
+
+// checkCopy returns an error if the given client can't currently copy
+// (or, if move is true, rename) the source key to the destination key.
+// Server.mu and Server.lockMu must be held by the caller.
+func (s *Server) checkCopy(
+	cfg Config,
+	clientID string,
+	source string,
+	destination string,
+	overwrite bool,
+	move bool,
+) error {
+	if s.getKey(source) == nil {
+		return ErrKeyNotFound
+	}
+	for _, key := range []string{source, destination} {
+		if keyLock, locked := s.locks[key]; locked && keyLock.ClientID != clientID {
+			return ErrLocked
+		}
+	}
+	// Shared locks only prevent writes, so a copied source can have them
+	if s.sharedLockedByOthers(destination, clientID) ||
+		(move && s.sharedLockedByOthers(source, clientID)) {
+		return ErrSharedLocked
+	}
+
+	dstKV := s.getKey(destination)
+	switch {
+	case dstKV != nil && !overwrite:
+		return checkWriteCondition(
+			destination,
+			dstKV,
+			pb.WriteMode_CREATE_ONLY,
+			nil,
+			nil,
+		)
+	case dstKV != nil, move:
+		// The number of keys won't change
+		return nil
+	}
+
+	maxKeys := cfg.MaxNumberOfKeys
+	if maxKeys > 0 && s.numKeys.Load() >= maxKeys {
+		return ErrMaxKeysReached
+	}
+	namespace, _ := splitNamespacedKey(destination)
+	return s.checkNamespaceMaxKeys(cfg, namespace, 1)
+}
+
+// copyKey copies the source key to the destination key, and deletes the
+// source key if move is true. The destination's new version is returned.
+func (s *Server) copyKey(
+	ctx context.Context,
+	logger *slog.Logger,
+	source string,
+	destination string,
+	overwrite bool,
+	includeHistory bool,
+	move bool,
+) (uint64, error) {
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return 0, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if err := validateCopyRequest(cfg, source, destination); err != nil {
+		return 0, err
+	}
+	source = s.namespacedKey(ctx, source)
+	destination = s.namespacedKey(ctx, destination)
+	clientID := s.ClientID(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lockMu.Lock()
+	defer s.lockMu.Unlock()
+
+	if err := s.checkCopy(
+		cfg,
+		clientID,
+		source,
+		destination,
+		overwrite,
+		move,
+	); err != nil {
+		logger.Info("unable to copy key", "error", err)
+		return 0, err
+	}
+
+	// Only regular values are checked against schemas
+	srcKV := s.store[source]
+	srcKV.mu.RLock()
+	var schemaErr error
+	if srcKV.Type == pb.ValueType_STRING {
+		var value []byte
+		if value, schemaErr = srcKV.rawValue(s.keys); schemaErr == nil {
+			schemaErr = s.checkSchema(destination, value)
+		}
+	}
+	srcKV.mu.RUnlock()
+	if schemaErr != nil {
+		logger.Info("unable to copy key", "error", schemaErr)
+		return 0, schemaErr
+	}
+
+	s.reaperMu.Lock()
+	defer s.reaperMu.Unlock()
+
+	s.hmu.Lock()
+	defer s.hmu.Unlock()
+
+	srcKV.mu.RLock()
+	value := make([]byte, len(srcKV.Value))
+	copy(value, srcKV.Value)
+	contentType := srcKV.ContentType
+	labels := maps.Clone(srcKV.Labels)
+	hash := srcKV.Hash
+	srcVersion := srcKV.Version
+	valueType := srcKV.Type
+	list := cloneValues(srcKV.List)
+	fields := cloneFields(srcKV.Fields)
+	members := maps.Clone(srcKV.Members)
+	scores := maps.Clone(srcKV.Scores)
+	compressed := srcKV.Compressed
+	encrypted := srcKV.Encrypted
+	size := srcKV.Size
+	leaseID := srcKV.LeaseID
+	srcKV.mu.RUnlock()
+
+	dstKV, exists := s.store[destination]
+
+	// With its history, the destination picks up where the source
+	// left off, unless it's overwritten and already past that version,
+	// as versions never go backwards. Otherwise, it's versioned as if
+	// the value had been set.
+	var version uint64 = 1
+	switch {
+	case includeHistory && exists:
+		version = max(srcVersion, dstKV.Version+1)
+	case includeHistory:
+		version = srcVersion
+	case exists:
+		version = dstKV.Version + 1
+	default:
+		if kh := s.history[destination]; len(kh) > 0 {
+			version = kh[len(kh)-1].Version + 1
+		}
+	}
+
+	// The destination is bound to the source's lease (if any), in place
+	// of its own
+	var dstLeaseID string
+
+	now := time.Now()
+	switch {
+	case exists:
+		dstKV.mu.Lock()
+		dstLeaseID = dstKV.LeaseID
+		s.totalSize.Add(^(dstKV.Size - 1))
+		s.totalSize.Add(size)
+		s.storedSize.Add(^(dstKV.storedSize() - 1))
+		s.resizeNamespaceKey(destination, dstKV.Size, size)
+		dstKV.Value = value
+		dstKV.Compressed = compressed
+		dstKV.Encrypted = encrypted
+		dstKV.Type = valueType
+		dstKV.List = list
+		dstKV.Fields = fields
+		dstKV.Members = members
+		dstKV.Scores = scores
+		dstKV.ContentType = contentType
+		dstKV.Labels = labels
+		dstKV.Size = size
+		dstKV.Hash = hash
+		dstKV.Version = version
+		dstKV.Updated = now
+		dstKV.LeaseID = leaseID
+		s.storedSize.Add(dstKV.storedSize())
+		dstKV.mu.Unlock()
+		s.emit(destination, Updated, clientID, &now)
+	default:
+		dstKV = &keyValue{
+			Key:         destination,
+			Value:       value,
+			Type:        valueType,
+			List:        list,
+			Fields:      fields,
+			Members:     members,
+			Scores:      scores,
+			Compressed:  compressed,
+			Encrypted:   encrypted,
+			ContentType: contentType,
+			Labels:      labels,
+			Size:        size,
+			Hash:        hash,
+			Version:     version,
+			Created:     now,
+			CreatedBy:   clientID,
+			LeaseID:     leaseID,
+		}
+		s.addKeyValueInfo(dstKV, size)
+	}
+	if dstLeaseID != leaseID {
+		s.unbindLease(dstLeaseID, destination, false)
+	}
+	s.bindLease(leaseID, destination, false)
+
+	// The destination takes on the source's lifespan, or lack thereof
+	if dstReaper, ok := s.reapers[destination]; ok {
+		if dstReaper.t != nil {
+			_ = dstReaper.t.Stop()
+		}
+		delete(s.reapers, destination)
+		s.numReapers.Add(decrementUint64)
+	}
+	if srcReaper, ok := s.reapers[source]; ok {
+		dstReaper := srcReaper.clone(destination, clientID)
+		logger.Info("created new reaper", "reaper", dstReaper)
+	}
+
+	switch {
+	case includeHistory:
+		delete(s.history, destination)
+		if kh, ok := s.history[source]; ok {
+			dstHistory := make([]*keyValueSnapshot, 0, cap(kh))
+			for _, snapshot := range kh {
+				dstSnapshot := *snapshot
+				dstSnapshot.Key = destination
+				dstHistory = append(dstHistory, &dstSnapshot)
+			}
+			s.history[destination] = dstHistory
+		}
+		// The source's history ends at its own version
+		if version != srcVersion && valueType == pb.ValueType_STRING {
+			_ = s.addKeyValueSnapshot(dstKV, cfg.RevisionLimit)
+		}
+	case valueType == pb.ValueType_STRING:
+		// Only regular values are versioned
+		_ = s.addKeyValueSnapshot(dstKV, cfg.RevisionLimit)
+	}
+
+	switch {
+	case move:
+		srcKV.mu.Lock()
+		s.deleteKey(source, clientID, Deleted)
+		srcKV.mu.Unlock()
+		if includeHistory || !cfg.KeepKeyHistoryAfterDelete {
+			delete(s.history, source)
+		}
+		logger.Info("renamed key", kvLogKey, dstKV)
+	default:
+		s.emit(source, Accessed, clientID, &now)
+		logger.Info("copied key", kvLogKey, dstKV)
+	}
+	return version, nil
+}
+
+// clone creates a new reaper for the given key, with the same lifespan as
+// the current reaper, which will expire the key at the same time. Server.mu
+// and Server.reaperMu must be held by the caller.
+func (r *reaper) clone(key string, clientID string) *reaper {
+	now := time.Now()
+	c := &reaper{
+		Key:         key,
+		Lifespan:    r.Lifespan,
+		LifespanSet: r.LifespanSet,
+		ExpireAt:    r.ExpireAt,
+		srv:         r.srv,
+		ID:          uuid.NewString(),
+	}
+	r.srv.reapers[key] = c
+	r.srv.numReapers.Add(1)
+	c.t = time.AfterFunc(
+		time.Until(r.expiresAt()),
+		c.ExpireFunc(),
+	)
+	r.srv.emit(key, LifespanSet, clientID, &now)
+	return c
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
+)
+
+// findRevision returns the revision of a key with the given version.
+// As with GetRevision, a negative version is relative to the number of
+// revisions held. hmu must be held by the caller.
+func (s *Server) findRevision(key string, version int64) (
+	*keyValueSnapshot,
+	error,
+) {
+	var h *keyValueSnapshot
+	var found bool
+
+	switch {
+	case version > 0:
+		h, found = s.getVersion(key, version)
+	case version < 0:
+		h, found = s.getRelativeVersion(key, version)
+	default:
+		return nil, KQError{
+			Message: "revision must be greater or less than zero",
+			Code:    codes.InvalidArgument,
+		}
+	}
+
+	if !found {
+		return nil, ErrRevisionNotFound
+	}
+	return h, nil
+}
+
+// ListRevisions returns the version, timestamp, hash, size and content
+// type of each revision held in a key's history, oldest first. If the
+// key doesn't exist and has no history, ErrKeyNotFound is returned.
+func (s *Server) ListRevisions(ctx context.Context, in *pb.Key) (
+	*pb.ListRevisionsResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	logger.Info("listing revisions", slog.String("key", in.Key))
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if cfg.RevisionLimit == 0 {
+		return nil, ErrVersioningDisabled
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	s.hmu.RLock()
+	defer s.hmu.RUnlock()
+
+	kh := s.history[key]
+	if len(kh) == 0 && s.getKey(key) == nil {
+		return nil, ErrKeyNotFound
+	}
+
+	revisions := make([]*pb.Revision, 0, len(kh))
+	for _, h := range kh {
+		revisions = append(
+			revisions, &pb.Revision{
+				Version:     h.Version,
+				Timestamp:   timestamppb.New(h.Timestamp),
+				Hash:        h.Hash,
+				Size:        h.Size,
+				ContentType: h.ContentType,
+			},
+		)
+	}
+	return &pb.ListRevisionsResponse{Revisions: revisions}, nil
+}
+
+// Rollback sets the value of a key to the value of one of its revisions,
+// as with Set, so it's recorded as a new version (if the value differs
+// from the current value), and the same checks apply. The revision is
+// found and set under the same lock, so no other write can land between
+// the two.
+func (s *Server) Rollback(ctx context.Context, in *pb.RollbackRequest) (
+	*pb.RollbackResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	logger.Info(
+		"request to roll back key",
+		slog.String("key", in.Key),
+		slog.Int64("version", in.Version),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if cfg.RevisionLimit == 0 {
+		return nil, ErrVersioningDisabled
+	}
+	kv := &pb.KeyValue{Key: in.Key}
+	if _, _, err := validateSetRequest(cfg, kv); err != nil {
+		return nil, err
+	}
+	kv.Key = s.namespacedKey(ctx, kv.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// setKey takes hmu itself, so it's only held to read the revision
+	s.hmu.RLock()
+	h, err := s.findRevision(kv.Key, in.Version)
+	if err == nil {
+		kv.Value, err = h.rawValue(s.keys)
+		kv.ContentType = h.ContentType
+	}
+	s.hmu.RUnlock()
+	if err != nil {
+		logger.Info("unable to get revision", "error", err)
+		return nil, err
+	}
+	if cfg.MaxValueSize > 0 && uint64(len(kv.Value)) > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+
+	resp, err := s.setKey(logger, cfg, s.ClientID(ctx), kv, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(
+		"rolled back key",
+		slog.String("key", in.Key),
+		slog.Int64("revision", in.Version),
+		slog.Uint64("version", resp.Version),
+	)
+	return &pb.RollbackResponse{Version: resp.Version, Hash: resp.Hash}, nil
+}
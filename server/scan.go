@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Scan streams the keys matching the given range and/or prefix, sorted
+// lexicographically, along with their values and metadata. Results are
+// collected while holding Server.mu, so they reflect the state of the
+// store at the time of the request, and are sent after it's released.
+//
+// As with Get, values of keys locked by another client aren't returned.
+// Those keys are skipped, unless include_locked is set, in which case
+// they're sent with locked_by_other set and no value.
+//
+// Keys with LIST, HASH, SET or ZSET values are included, with their
+// value_type set, but without a value (as Get doesn't return them either).
+func (s *Server) Scan(
+	in *pb.ScanRequest,
+	stream pb.KeyQuarry_ScanServer,
+) error {
+	ctx := stream.Context()
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(
+		attribute.String("prefix", in.Prefix),
+		attribute.String("start", in.Start),
+		attribute.String("end", in.End),
+	)
+
+	logger := s.requestLogger(ctx)
+	logger.Info(
+		"scanning keys",
+		slog.String("prefix", in.Prefix),
+		slog.String("start", in.Start),
+		slog.String("end", in.End),
+		slog.Uint64("limit", in.Limit),
+		slog.Bool("include_reserved", in.IncludeReserved),
+		slog.Bool("include_locked", in.IncludeLocked),
+	)
+
+	if in.End != "" && in.Start >= in.End {
+		return KQError{
+			Message: "start must be sorted before end",
+			Code:    codes.InvalidArgument,
+		}
+	}
+
+	results := s.scanKeys(ctx, in)
+	logger.Debug("scanned keys", slog.Int("count", len(results)))
+
+	clientID := s.ClientID(ctx)
+	for _, result := range results {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := stream.Send(result); err != nil {
+			logger.Error("error sending scan result", "error", err)
+			return err
+		}
+		if !result.LockedByOther {
+			now := time.Now()
+			s.emit(s.namespacedKey(ctx, result.Key), Accessed, clientID, &now)
+		}
+	}
+	return nil
+}
+
+// scanKeys returns the results for the given [pb.ScanRequest], sorted
+// by key
+func (s *Server) scanKeys(
+	ctx context.Context,
+	in *pb.ScanRequest,
+) []*pb.ScanResult {
+	clientID := s.ClientID(ctx)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	namespace := s.Namespace(ctx)
+	keys := make([]string, 0, len(s.store))
+	for internalKey := range s.store {
+		keyNamespace, k := splitNamespacedKey(internalKey)
+		switch {
+		case keyNamespace != namespace:
+			continue
+		case !in.IncludeReserved && strings.HasPrefix(
+			strings.ToLower(k),
+			ReservedKeyPrefix,
+		):
+			continue
+		case !strings.HasPrefix(k, in.Prefix):
+			continue
+		case k < in.Start:
+			continue
+		case in.End != "" && k >= in.End:
+			continue
+		}
+		keys = append(keys, internalKey)
+	}
+	sort.Strings(keys)
+
+	s.lockMu.RLock()
+	defer s.lockMu.RUnlock()
+
+	s.reaperMu.RLock()
+	defer s.reaperMu.RUnlock()
+
+	limit := int(in.Limit)
+	results := make([]*pb.ScanResult, 0, len(keys))
+	for _, k := range keys {
+		if limit > 0 && len(results) >= limit {
+			break
+		}
+
+		keyLock, exclusive := s.locks[k]
+		lockedByOther := exclusive && keyLock.ClientID != clientID
+		if lockedByOther && !in.IncludeLocked {
+			continue
+		}
+
+		kvInfo := s.store[k]
+		kvInfo.mu.RLock()
+		result := &pb.ScanResult{
+			Key:           keyName(k),
+			ContentType:   kvInfo.ContentType,
+			Version:       kvInfo.Version,
+			Hash:          kvInfo.Hash,
+			Size:          kvInfo.Size,
+			Locked:        s.keyLocked(k),
+			LockedByOther: lockedByOther,
+			ValueType:     kvInfo.Type,
+		}
+		if !lockedByOther && kvInfo.Type == pb.ValueType_STRING {
+			value, err := kvInfo.rawValue(s.keys)
+			if err != nil {
+				s.logger.Error("error decompressing value", "error", err, "key", k)
+			}
+			result.Value = value
+		}
+		kvInfo.mu.RUnlock()
+
+		if keyReaper, hasExpiry := s.reapers[k]; hasExpiry {
+			result.Lifespan = durationpb.New(keyReaper.Lifespan)
+			result.LifespanSet = timestamppb.New(keyReaper.LifespanSet)
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,795 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxSchemaErrors is the maximum number of validation errors included
+// in the error returned for a value which doesn't match its schema
+const maxSchemaErrors = 10
+
+var (
+	ErrSchemaValidation = KQError{
+		Message: "value doesn't match schema",
+		Code:    codes.InvalidArgument,
+	}
+	ErrInvalidSchema = KQError{
+		Message: "invalid JSON Schema",
+		Code:    codes.InvalidArgument,
+	}
+)
+
+// unsupportedSchemaKeywords are JSON Schema keywords which affect
+// validation, but aren't implemented. Schemas using them are rejected,
+// rather than silently accepting values they should reject.
+var unsupportedSchemaKeywords = []string{
+	"$ref",
+	"$dynamicRef",
+	"$recursiveRef",
+	"patternProperties",
+	"propertyNames",
+	"dependencies",
+	"dependentRequired",
+	"dependentSchemas",
+	"if",
+	"then",
+	"else",
+	"prefixItems",
+	"additionalItems",
+	"contains",
+	"minContains",
+	"maxContains",
+	"unevaluatedItems",
+	"unevaluatedProperties",
+}
+
+var schemaPropertyNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// SchemaConfig binds a JSON Schema to a key prefix. Values set on keys
+// with the prefix, in the given namespace, must validate against the
+// schema. See jsonSchema for the supported keywords.
+type SchemaConfig struct {
+	// Namespace is the namespace of the keys the schema applies to.
+	// Defaults to the default namespace.
+	Namespace string `json:"namespace,omitempty" yaml:"namespace,omitempty" mapstructure:"namespace"`
+
+	// Prefix is the key prefix the schema applies to. An empty prefix
+	// applies to every key in the namespace.
+	Prefix string `json:"prefix" yaml:"prefix" mapstructure:"prefix"`
+
+	// Schema is the JSON Schema document
+	Schema string `json:"schema" yaml:"schema" mapstructure:"schema"`
+}
+
+// keySchema is a compiled JSON Schema bound to a key prefix
+type keySchema struct {
+	Namespace string
+	Prefix    string
+	Source    []byte
+	schema    *jsonSchema
+}
+
+// newKeySchema compiles the given JSON Schema document, returning
+// ErrInvalidSchema (with details) if it's invalid
+func newKeySchema(namespace, prefix string, source []byte) (
+	*keySchema,
+	error,
+) {
+	if namespace != "" {
+		if err := validateNamespace(namespace); err != nil {
+			return nil, err
+		}
+	}
+	doc, err := decodeJSON(source)
+	if err != nil {
+		return nil, KQError{
+			Message: fmt.Sprintf("%s: %s", ErrInvalidSchema.Message, err),
+			Code:    ErrInvalidSchema.Code,
+		}
+	}
+	schema, err := compileJSONSchema(doc, "#")
+	if err != nil {
+		return nil, KQError{
+			Message: fmt.Sprintf("%s: %s", ErrInvalidSchema.Message, err),
+			Code:    ErrInvalidSchema.Code,
+		}
+	}
+	src := make([]byte, len(source))
+	copy(src, source)
+	return &keySchema{
+		Namespace: namespace,
+		Prefix:    prefix,
+		Source:    src,
+		schema:    schema,
+	}, nil
+}
+
+// compileSchemaConfigs compiles the schemas in the given SchemaConfig
+// values, mapped by their namespaced prefix
+func compileSchemaConfigs(schemas []SchemaConfig) (
+	map[string]*keySchema,
+	error,
+) {
+	rv := make(map[string]*keySchema, len(schemas))
+	for _, sc := range schemas {
+		ks, err := newKeySchema(sc.Namespace, sc.Prefix, []byte(sc.Schema))
+		if err != nil {
+			return nil, fmt.Errorf(
+				"schema for prefix '%s': %w",
+				sc.Prefix,
+				err,
+			)
+		}
+		nsPrefix := namespacedKey(sc.Namespace, sc.Prefix)
+		if _, exists := rv[nsPrefix]; exists {
+			return nil, fmt.Errorf(
+				"duplicate schema for prefix '%s'",
+				sc.Prefix,
+			)
+		}
+		rv[nsPrefix] = ks
+	}
+	return rv, nil
+}
+
+// schemaFor returns the schema bound to the longest prefix of the given
+// (internal) key, in its namespace, or nil if there isn't one
+func (s *Server) schemaFor(key string) *keySchema {
+	namespace, name := splitNamespacedKey(key)
+
+	s.schemaMu.RLock()
+	defer s.schemaMu.RUnlock()
+
+	var rv *keySchema
+	for _, ks := range s.schemas {
+		if ks.Namespace != namespace || !strings.HasPrefix(name, ks.Prefix) {
+			continue
+		}
+		if rv == nil || len(ks.Prefix) > len(rv.Prefix) {
+			rv = ks
+		}
+	}
+	return rv
+}
+
+// checkSchema returns an error if the given value doesn't validate
+// against the schema bound to the given (internal) key's prefix, if
+// any. The error includes (up to maxSchemaErrors) validation errors.
+func (s *Server) checkSchema(key string, value []byte) error {
+	ks := s.schemaFor(key)
+	if ks == nil {
+		return nil
+	}
+
+	var errs []string
+	doc, err := decodeJSON(value)
+	switch {
+	case err != nil:
+		errs = []string{"value is not valid JSON"}
+	default:
+		errs = ks.schema.validate(doc, "$", nil)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	if len(errs) > maxSchemaErrors {
+		errs = append(
+			errs[:maxSchemaErrors],
+			fmt.Sprintf("(%d more)", len(errs)-maxSchemaErrors),
+		)
+	}
+	return KQError{
+		Message: fmt.Sprintf(
+			"%s for prefix '%s': %s",
+			ErrSchemaValidation.Message,
+			ks.Prefix,
+			strings.Join(errs, "; "),
+		),
+		Code: ErrSchemaValidation.Code,
+	}
+}
+
+// setSchema binds the given schema to a prefix in a namespace, returning
+// true if it replaced an existing schema
+func (s *Server) setSchema(ks *keySchema) bool {
+	nsPrefix := namespacedKey(ks.Namespace, ks.Prefix)
+
+	s.schemaMu.Lock()
+	defer s.schemaMu.Unlock()
+
+	_, exists := s.schemas[nsPrefix]
+	s.schemas[nsPrefix] = ks
+	return exists
+}
+
+// deleteSchema removes the schema bound to a prefix in a namespace,
+// returning true if there was one
+func (s *Server) deleteSchema(namespace, prefix string) bool {
+	nsPrefix := namespacedKey(namespace, prefix)
+
+	s.schemaMu.Lock()
+	defer s.schemaMu.Unlock()
+
+	_, exists := s.schemas[nsPrefix]
+	delete(s.schemas, nsPrefix)
+	return exists
+}
+
+// listSchemas returns the schemas bound to prefixes in the given
+// namespace, sorted by prefix
+func (s *Server) listSchemas(namespace string) []*keySchema {
+	s.schemaMu.RLock()
+	rv := make([]*keySchema, 0, len(s.schemas))
+	for _, ks := range s.schemas {
+		if ks.Namespace == namespace {
+			rv = append(rv, ks)
+		}
+	}
+	s.schemaMu.RUnlock()
+
+	slices.SortFunc(
+		rv, func(a, b *keySchema) int {
+			return strings.Compare(a.Prefix, b.Prefix)
+		},
+	)
+	return rv
+}
+
+// jsonSchema is a compiled JSON Schema. The validation keywords for
+// types, enum/const, numbers, strings, arrays and objects are
+// supported, along with allOf, anyOf, oneOf and not. Annotations (such
+// as title, description and format) are ignored, and schemas using
+// other keywords which affect validation (such as $ref) are rejected.
+type jsonSchema struct {
+	// always is set for the boolean schemas true and false
+	always *bool
+
+	types []string
+
+	enum     []any
+	constVal any
+	hasConst bool
+
+	minimum          *float64
+	maximum          *float64
+	exclusiveMinimum *float64
+	exclusiveMaximum *float64
+	multipleOf       *float64
+
+	minLength *int
+	maxLength *int
+	pattern   *regexp.Regexp
+
+	items       *jsonSchema
+	minItems    *int
+	maxItems    *int
+	uniqueItems bool
+
+	properties           map[string]*jsonSchema
+	required             []string
+	additionalProperties *jsonSchema
+	minProperties        *int
+	maxProperties        *int
+
+	allOf []*jsonSchema
+	anyOf []*jsonSchema
+	oneOf []*jsonSchema
+	not   *jsonSchema
+}
+
+// compileJSONSchema compiles the given decoded JSON Schema document.
+// The location is used in error messages.
+func compileJSONSchema(doc any, location string) (*jsonSchema, error) {
+	switch v := doc.(type) {
+	case bool:
+		return &jsonSchema{always: &v}, nil
+	case map[string]any:
+		return compileSchemaObject(v, location)
+	default:
+		return nil, fmt.Errorf("%s: schema must be an object or boolean", location)
+	}
+}
+
+func compileSchemaObject(obj map[string]any, location string) (
+	*jsonSchema,
+	error,
+) {
+	for _, keyword := range unsupportedSchemaKeywords {
+		if _, ok := obj[keyword]; ok {
+			return nil, fmt.Errorf(
+				"%s: unsupported keyword '%s'",
+				location,
+				keyword,
+			)
+		}
+	}
+
+	var err error
+	schema := &jsonSchema{}
+
+	if t, ok := obj["type"]; ok {
+		if schema.types, err = schemaTypes(t, location); err != nil {
+			return nil, err
+		}
+	}
+
+	if e, ok := obj["enum"]; ok {
+		values, isArray := e.([]any)
+		if !isArray {
+			return nil, fmt.Errorf("%s/enum: must be an array", location)
+		}
+		schema.enum = values
+	}
+	schema.constVal, schema.hasConst = obj["const"]
+
+	numbers := map[string]**float64{
+		"minimum":          &schema.minimum,
+		"maximum":          &schema.maximum,
+		"exclusiveMinimum": &schema.exclusiveMinimum,
+		"exclusiveMaximum": &schema.exclusiveMaximum,
+		"multipleOf":       &schema.multipleOf,
+	}
+	for keyword, dst := range numbers {
+		v, ok := obj[keyword]
+		if !ok {
+			continue
+		}
+		n, isNumber := v.(json.Number)
+		if !isNumber {
+			return nil, fmt.Errorf("%s/%s: must be a number", location, keyword)
+		}
+		f, e := n.Float64()
+		if e != nil {
+			return nil, fmt.Errorf("%s/%s: %w", location, keyword, e)
+		}
+		*dst = &f
+	}
+	if schema.multipleOf != nil && *schema.multipleOf <= 0 {
+		return nil, fmt.Errorf("%s/multipleOf: must be greater than 0", location)
+	}
+
+	counts := map[string]**int{
+		"minLength":     &schema.minLength,
+		"maxLength":     &schema.maxLength,
+		"minItems":      &schema.minItems,
+		"maxItems":      &schema.maxItems,
+		"minProperties": &schema.minProperties,
+		"maxProperties": &schema.maxProperties,
+	}
+	for keyword, dst := range counts {
+		v, ok := obj[keyword]
+		if !ok {
+			continue
+		}
+		n, isNumber := v.(json.Number)
+		if !isNumber {
+			return nil, fmt.Errorf(
+				"%s/%s: must be a non-negative integer",
+				location,
+				keyword,
+			)
+		}
+		i, e := n.Int64()
+		if e != nil || i < 0 {
+			return nil, fmt.Errorf(
+				"%s/%s: must be a non-negative integer",
+				location,
+				keyword,
+			)
+		}
+		c := int(i)
+		*dst = &c
+	}
+
+	if p, ok := obj["pattern"]; ok {
+		pattern, isString := p.(string)
+		if !isString {
+			return nil, fmt.Errorf("%s/pattern: must be a string", location)
+		}
+		if schema.pattern, err = regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("%s/pattern: %w", location, err)
+		}
+	}
+
+	if u, ok := obj["uniqueItems"]; ok {
+		if schema.uniqueItems, ok = u.(bool); !ok {
+			return nil, fmt.Errorf("%s/uniqueItems: must be a boolean", location)
+		}
+	}
+
+	if r, ok := obj["required"]; ok {
+		names, isArray := r.([]any)
+		if !isArray {
+			return nil, fmt.Errorf("%s/required: must be an array", location)
+		}
+		for _, n := range names {
+			name, isString := n.(string)
+			if !isString {
+				return nil, fmt.Errorf(
+					"%s/required: must be an array of strings",
+					location,
+				)
+			}
+			schema.required = append(schema.required, name)
+		}
+	}
+
+	if p, ok := obj["properties"]; ok {
+		props, isObject := p.(map[string]any)
+		if !isObject {
+			return nil, fmt.Errorf("%s/properties: must be an object", location)
+		}
+		schema.properties = make(map[string]*jsonSchema, len(props))
+		for name, propSchema := range props {
+			schema.properties[name], err = compileJSONSchema(
+				propSchema,
+				fmt.Sprintf("%s/properties/%s", location, name),
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	subschemas := map[string]**jsonSchema{
+		"additionalProperties": &schema.additionalProperties,
+		"items":                &schema.items,
+		"not":                  &schema.not,
+	}
+	for keyword, dst := range subschemas {
+		v, ok := obj[keyword]
+		if !ok {
+			continue
+		}
+		if *dst, err = compileJSONSchema(
+			v,
+			fmt.Sprintf("%s/%s", location, keyword),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	combined := map[string]*[]*jsonSchema{
+		"allOf": &schema.allOf,
+		"anyOf": &schema.anyOf,
+		"oneOf": &schema.oneOf,
+	}
+	for keyword, dst := range combined {
+		v, ok := obj[keyword]
+		if !ok {
+			continue
+		}
+		schemas, isArray := v.([]any)
+		if !isArray || len(schemas) == 0 {
+			return nil, fmt.Errorf(
+				"%s/%s: must be a non-empty array",
+				location,
+				keyword,
+			)
+		}
+		for i, sub := range schemas {
+			compiled, e := compileJSONSchema(
+				sub,
+				fmt.Sprintf("%s/%s/%d", location, keyword, i),
+			)
+			if e != nil {
+				return nil, e
+			}
+			*dst = append(*dst, compiled)
+		}
+	}
+
+	return schema, nil
+}
+
+// schemaTypes returns the type names from the given "type" keyword
+func schemaTypes(t any, location string) ([]string, error) {
+	var names []string
+	switch v := t.(type) {
+	case string:
+		names = []string{v}
+	case []any:
+		for _, n := range v {
+			name, ok := n.(string)
+			if !ok {
+				return nil, fmt.Errorf(
+					"%s/type: must be a string or array of strings",
+					location,
+				)
+			}
+			names = append(names, name)
+		}
+	default:
+		return nil, fmt.Errorf(
+			"%s/type: must be a string or array of strings",
+			location,
+		)
+	}
+	for _, name := range names {
+		switch name {
+		case "null", "boolean", "object", "array", "number", "integer", "string":
+		default:
+			return nil, fmt.Errorf("%s/type: unknown type '%s'", location, name)
+		}
+	}
+	return names, nil
+}
+
+// jsonTypeOf returns the JSON Schema type name of the given decoded
+// JSON value (see decodeJSON). Numbers are always "number".
+func jsonTypeOf(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case json.Number:
+		return "number"
+	default:
+		return "string"
+	}
+}
+
+// isJSONInteger returns true if the given number has no fractional part
+func isJSONInteger(n json.Number) bool {
+	f, err := n.Float64()
+	return err == nil && f == math.Trunc(f) && !math.IsInf(f, 0)
+}
+
+// schemaPath returns the JSONPath expression for the given member of the
+// object at path
+func schemaPath(path string, name string) string {
+	if schemaPropertyNamePattern.MatchString(name) {
+		return path + "." + name
+	}
+	return fmt.Sprintf("%s['%s']", path, name)
+}
+
+// validate validates the given decoded JSON value (see decodeJSON),
+// appending any errors to errs, prefixed with the JSONPath of the
+// element they apply to
+func (s *jsonSchema) validate(v any, path string, errs []string) []string {
+	if s.always != nil {
+		if !*s.always {
+			errs = append(errs, path+": not allowed")
+		}
+		return errs
+	}
+
+	if len(s.types) > 0 {
+		valueType := jsonTypeOf(v)
+		matched := slices.Contains(s.types, valueType)
+		if !matched && valueType == "number" && slices.Contains(s.types, "integer") {
+			matched = isJSONInteger(v.(json.Number))
+		}
+		if !matched {
+			return append(
+				errs,
+				fmt.Sprintf(
+					"%s: expected %s, got %s",
+					path,
+					strings.Join(s.types, " or "),
+					valueType,
+				),
+			)
+		}
+	}
+
+	if s.enum != nil && !slices.ContainsFunc(
+		s.enum, func(e any) bool {
+			return jsonEqual(e, v)
+		},
+	) {
+		errs = append(errs, path+": must be one of the values in enum")
+	}
+	if s.hasConst && !jsonEqual(s.constVal, v) {
+		errs = append(errs, path+": must be equal to const")
+	}
+
+	switch value := v.(type) {
+	case json.Number:
+		errs = s.validateNumber(value, path, errs)
+	case string:
+		errs = s.validateString(value, path, errs)
+	case []any:
+		errs = s.validateArray(value, path, errs)
+	case map[string]any:
+		errs = s.validateObject(value, path, errs)
+	}
+
+	for _, sub := range s.allOf {
+		errs = sub.validate(v, path, errs)
+	}
+	if len(s.anyOf) > 0 && !slices.ContainsFunc(
+		s.anyOf, func(sub *jsonSchema) bool {
+			return len(sub.validate(v, path, nil)) == 0
+		},
+	) {
+		errs = append(errs, path+": must match a schema in anyOf")
+	}
+	if len(s.oneOf) > 0 {
+		var matched int
+		for _, sub := range s.oneOf {
+			if len(sub.validate(v, path, nil)) == 0 {
+				matched++
+			}
+		}
+		if matched != 1 {
+			errs = append(
+				errs,
+				fmt.Sprintf(
+					"%s: must match exactly one schema in oneOf (matched %d)",
+					path,
+					matched,
+				),
+			)
+		}
+	}
+	if s.not != nil && len(s.not.validate(v, path, nil)) == 0 {
+		errs = append(errs, path+": must not match the schema in not")
+	}
+	return errs
+}
+
+func (s *jsonSchema) validateNumber(
+	n json.Number,
+	path string,
+	errs []string,
+) []string {
+	f, err := n.Float64()
+	if err != nil {
+		return append(errs, fmt.Sprintf("%s: invalid number", path))
+	}
+	if s.minimum != nil && f < *s.minimum {
+		errs = append(errs, fmt.Sprintf("%s: must be >= %v", path, *s.minimum))
+	}
+	if s.maximum != nil && f > *s.maximum {
+		errs = append(errs, fmt.Sprintf("%s: must be <= %v", path, *s.maximum))
+	}
+	if s.exclusiveMinimum != nil && f <= *s.exclusiveMinimum {
+		errs = append(
+			errs,
+			fmt.Sprintf("%s: must be > %v", path, *s.exclusiveMinimum),
+		)
+	}
+	if s.exclusiveMaximum != nil && f >= *s.exclusiveMaximum {
+		errs = append(
+			errs,
+			fmt.Sprintf("%s: must be < %v", path, *s.exclusiveMaximum),
+		)
+	}
+	if s.multipleOf != nil {
+		q := f / *s.multipleOf
+		if math.IsInf(q, 0) || q != math.Trunc(q) {
+			errs = append(
+				errs,
+				fmt.Sprintf("%s: must be a multiple of %v", path, *s.multipleOf),
+			)
+		}
+	}
+	return errs
+}
+
+func (s *jsonSchema) validateString(
+	value string,
+	path string,
+	errs []string,
+) []string {
+	length := utf8.RuneCountInString(value)
+	if s.minLength != nil && length < *s.minLength {
+		errs = append(
+			errs,
+			fmt.Sprintf("%s: must be at least %d characters", path, *s.minLength),
+		)
+	}
+	if s.maxLength != nil && length > *s.maxLength {
+		errs = append(
+			errs,
+			fmt.Sprintf("%s: must be at most %d characters", path, *s.maxLength),
+		)
+	}
+	if s.pattern != nil && !s.pattern.MatchString(value) {
+		errs = append(
+			errs,
+			fmt.Sprintf("%s: must match pattern '%s'", path, s.pattern),
+		)
+	}
+	return errs
+}
+
+func (s *jsonSchema) validateArray(
+	items []any,
+	path string,
+	errs []string,
+) []string {
+	if s.minItems != nil && len(items) < *s.minItems {
+		errs = append(
+			errs,
+			fmt.Sprintf("%s: must have at least %d items", path, *s.minItems),
+		)
+	}
+	if s.maxItems != nil && len(items) > *s.maxItems {
+		errs = append(
+			errs,
+			fmt.Sprintf("%s: must have at most %d items", path, *s.maxItems),
+		)
+	}
+	if s.uniqueItems {
+	unique:
+		for i := range items {
+			for j := i + 1; j < len(items); j++ {
+				if jsonEqual(items[i], items[j]) {
+					errs = append(errs, path+": items must be unique")
+					break unique
+				}
+			}
+		}
+	}
+	if s.items != nil {
+		for i, item := range items {
+			errs = s.items.validate(item, fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	}
+	return errs
+}
+
+func (s *jsonSchema) validateObject(
+	obj map[string]any,
+	path string,
+	errs []string,
+) []string {
+	if s.minProperties != nil && len(obj) < *s.minProperties {
+		errs = append(
+			errs,
+			fmt.Sprintf(
+				"%s: must have at least %d properties",
+				path,
+				*s.minProperties,
+			),
+		)
+	}
+	if s.maxProperties != nil && len(obj) > *s.maxProperties {
+		errs = append(
+			errs,
+			fmt.Sprintf(
+				"%s: must have at most %d properties",
+				path,
+				*s.maxProperties,
+			),
+		)
+	}
+	for _, name := range s.required {
+		if _, ok := obj[name]; !ok {
+			errs = append(errs, schemaPath(path, name)+": required")
+		}
+	}
+
+	// Sorted, so errors are reported in a consistent order
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		propSchema, ok := s.properties[name]
+		if !ok {
+			propSchema = s.additionalProperties
+		}
+		if propSchema == nil {
+			continue
+		}
+		errs = propSchema.validate(obj[name], schemaPath(path, name), errs)
+	}
+	return errs
+}
@@ -0,0 +1,328 @@
+package server
+
+import (
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
+	"time"
+)
+
+var (
+	ErrInvalidPermitsMax = KQError{
+		Message: "permits_max must be greater than 0",
+		Code:    codes.InvalidArgument,
+	}
+	ErrSemaphorePermitsMismatch = KQError{
+		Message: "semaphore is held with a different permits_max",
+		Code:    codes.FailedPrecondition,
+	}
+	ErrNoSemaphorePermits = KQError{
+		Message: "no semaphore permits available",
+		Code:    codes.ResourceExhausted,
+	}
+)
+
+// semaphore limits the number of clients which can hold a permit on a key
+// at the same time. Each permit is a kvLock, released by its own timer.
+type semaphore struct {
+	// Key is the name of the key the semaphore is held on
+	Key string `json:"key"`
+	// PermitsMax is the number of permits which can be held at once
+	PermitsMax uint32 `json:"permits_max"`
+	// Permits are the permits currently held, by client ID
+	Permits map[string]*kvLock `json:"permits"`
+}
+
+// available returns the number of permits which can still be acquired
+func (sem *semaphore) available() uint32 {
+	held := uint32(len(sem.Permits))
+	if held >= sem.PermitsMax {
+		return 0
+	}
+	return sem.PermitsMax - held
+}
+
+// info returns the [pb.SemaphoreInfo] describing the semaphore, with
+// holders sorted by client ID
+func (sem *semaphore) info() *pb.SemaphoreInfo {
+	holders := make([]*pb.SemaphorePermit, 0, len(sem.Permits))
+	for _, permit := range sem.Permits {
+		holders = append(
+			holders,
+			&pb.SemaphorePermit{
+				ClientId: permit.ClientID,
+				Expires:  timestamppb.New(permit.expires),
+			},
+		)
+	}
+	sort.Slice(
+		holders, func(i, j int) bool {
+			return holders[i].ClientId < holders[j].ClientId
+		},
+	)
+	return &pb.SemaphoreInfo{
+		PermitsMax: sem.PermitsMax,
+		Available:  sem.available(),
+		Holders:    holders,
+	}
+}
+
+// AcquireSemaphore acquires a permit for the semaphore on a key, creating
+// the key if it doesn't exist. If the client already holds a permit, it's
+// renewed. If every permit is held by other clients, ErrNoSemaphorePermits
+// is returned, and if another client holds an exclusive lock on the key,
+// ErrLocked is returned.
+func (s *Server) AcquireSemaphore(
+	ctx context.Context,
+	in *pb.AcquireSemaphoreRequest,
+) (*pb.AcquireSemaphoreResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Name))
+
+	logger := s.requestLogger(ctx).With("key", in.Name)
+	clientID := s.ClientID(ctx)
+	logger.Info("acquire semaphore request", "permits_max", in.PermitsMax)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+
+	// Permits are held for the same durations allowed for locks
+	ttl, err := validateLockRequest(
+		cfg,
+		&pb.LockRequest{Key: in.Name, Duration: in.Ttl, CreateIfMissing: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if in.PermitsMax == 0 {
+		return nil, ErrInvalidPermitsMax
+	}
+	key := s.namespacedKey(ctx, in.Name)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lockMu.Lock()
+	defer s.lockMu.Unlock()
+
+	if keyLock, ok := s.locks[key]; ok && keyLock.ClientID != clientID {
+		logger.Info("key locked by another client")
+		return nil, ErrLocked
+	}
+
+	sem, exists := s.semaphores[key]
+	switch {
+	case !exists:
+	case sem.PermitsMax != in.PermitsMax:
+		return nil, ErrSemaphorePermitsMismatch
+	default:
+		if permit, ok := sem.Permits[clientID]; ok {
+			logger.Debug("renewing semaphore permit")
+			_ = permit.renew(ttl, clientID)
+			return &pb.AcquireSemaphoreResponse{
+				Available: sem.available(),
+				Expires:   timestamppb.New(permit.expires),
+			}, nil
+		}
+		if sem.available() == 0 {
+			logger.Info("no semaphore permits available")
+			return nil, ErrNoSemaphorePermits
+		}
+	}
+
+	if _, ok := s.store[key]; !ok {
+		if err = s.checkCreateKey(cfg, key); err != nil {
+			return nil, err
+		}
+		kvInfo := s.createEmptyKey(cfg, key, clientID, pb.ValueType_STRING)
+		logger.Info("created key", kvLogKey, kvInfo)
+	}
+	if !exists {
+		sem = &semaphore{
+			Key:        key,
+			PermitsMax: in.PermitsMax,
+			Permits:    make(map[string]*kvLock),
+		}
+		s.semaphores[key] = sem
+	}
+
+	permit := newSemaphorePermit(s, ttl, key, clientID)
+	sem.Permits[clientID] = permit
+	logger.Info("semaphore permit granted", "key_lock", permit)
+	return &pb.AcquireSemaphoreResponse{
+		Available: sem.available(),
+		Expires:   timestamppb.New(permit.expires),
+	}, nil
+}
+
+// ReleaseSemaphore releases the client's permit for the semaphore on a
+// key, if it holds one
+func (s *Server) ReleaseSemaphore(
+	ctx context.Context,
+	in *pb.ReleaseSemaphoreRequest,
+) (*pb.ReleaseSemaphoreResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Name))
+
+	logger := s.requestLogger(ctx).With("key", in.Name)
+	clientID := s.ClientID(ctx)
+	logger.Info("release semaphore request")
+
+	s.cfgMu.RLock()
+	readonly := s.cfg.Readonly
+	s.cfgMu.RUnlock()
+	if readonly {
+		return nil, ErrReadOnlyServer
+	}
+	if in.Name == "" {
+		return nil, ErrEmptyKey
+	}
+	key := s.namespacedKey(ctx, in.Name)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lockMu.Lock()
+	defer s.lockMu.Unlock()
+
+	sem, ok := s.semaphores[key]
+	if !ok {
+		return &pb.ReleaseSemaphoreResponse{Released: false}, nil
+	}
+	permitsMax := sem.PermitsMax
+	if !s.removePermit(key, clientID) {
+		return &pb.ReleaseSemaphoreResponse{
+			Released:  false,
+			Available: sem.available(),
+		}, nil
+	}
+	now := time.Now()
+	s.emit(key, Unlocked, clientID, &now)
+	logger.Info("released semaphore permit")
+
+	available := permitsMax
+	if sem, ok = s.semaphores[key]; ok {
+		available = sem.available()
+	}
+	return &pb.ReleaseSemaphoreResponse{
+		Released:  true,
+		Available: available,
+	}, nil
+}
+
+// newSemaphorePermit creates a new semaphore permit on a key for the
+// given client, and starts its timer. Server.lockMu must be held by
+// the caller.
+func newSemaphorePermit(
+	srv *Server,
+	d time.Duration,
+	key string,
+	clientID string,
+) *kvLock {
+	now := time.Now()
+	k := &kvLock{
+		Key:      key,
+		ClientID: clientID,
+		Duration: d,
+		srv:      srv,
+		Created:  now,
+		ID:       uuid.NewString(),
+		Permit:   true,
+		expires:  now.Add(d),
+	}
+	k.t = time.AfterFunc(d, k.UnlockFunc())
+	srv.emit(key, Locked, clientID, &now)
+	return k
+}
+
+// expirePermit releases the given client's semaphore permit on a key,
+// when its timer fires, if it's still the permit with the given ID.
+// Server.mu and Server.lockMu must be held by the caller.
+func (s *Server) expirePermit(key string, clientID string, lockID string) {
+	sem, ok := s.semaphores[key]
+	if !ok {
+		return
+	}
+	permit, ok := sem.Permits[clientID]
+	if !ok || permit.ID != lockID {
+		return
+	}
+	logger := s.logger.With(loggerKey, "semaphore", "key_lock", permit)
+	logger.Info("releasing semaphore permit on trigger")
+
+	s.removePermit(key, clientID)
+	now := time.Now()
+	s.emit(key, Unlocked, InternalClientID, &now)
+}
+
+// removePermit stops the timer for, and removes, the given client's
+// semaphore permit on a key. The semaphore is removed along with its
+// last permit. Returns false if the client doesn't hold a permit.
+// Server.lockMu must be held by the caller.
+func (s *Server) removePermit(key string, clientID string) bool {
+	sem, ok := s.semaphores[key]
+	if !ok {
+		return false
+	}
+	permit, ok := sem.Permits[clientID]
+	if !ok {
+		return false
+	}
+	if permit.t != nil {
+		_ = permit.t.Stop()
+	}
+	delete(sem.Permits, clientID)
+	if len(sem.Permits) == 0 {
+		delete(s.semaphores, key)
+	}
+	return true
+}
+
+// removeSemaphore removes the semaphore on a key, along with all of its
+// permits, returning the number of permits removed. Server.lockMu must
+// be held by the caller.
+func (s *Server) removeSemaphore(key string) int {
+	sem, ok := s.semaphores[key]
+	if !ok {
+		return 0
+	}
+	ct := len(sem.Permits)
+	for clientID := range sem.Permits {
+		s.removePermit(key, clientID)
+	}
+	return ct
+}
+
+// loadSemaphore restores a semaphore from a snapshot, restarting the
+// timers of its permits which haven't expired. Server.lockMu must be
+// held by the caller.
+func (s *Server) loadSemaphore(sem *semaphore) {
+	now := time.Now()
+	for clientID, permit := range sem.Permits {
+		if permit.Created.Add(permit.Duration).Before(now) {
+			s.logger.Warn(
+				"semaphore permit was held, but anticipated release time has passed",
+				"key_lock", permit,
+			)
+			delete(sem.Permits, clientID)
+			continue
+		}
+		permit.srv = s
+		permit.expires = now.Add(permit.Duration)
+		permit.t = time.AfterFunc(permit.Duration, permit.UnlockFunc())
+	}
+	if len(sem.Permits) == 0 {
+		return
+	}
+	s.semaphores[sem.Key] = sem
+}
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"container/heap"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"expvar"
@@ -35,6 +37,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"hash/fnv"
 	"log/slog"
+	"maps"
 	"net"
 	"net/http"
 	"net/http/pprof"
@@ -165,6 +168,10 @@ var (
 		Message: "value size is greater than maximum",
 		Code:    codes.FailedPrecondition,
 	}
+	ErrWrongType = KQError{
+		Message: "operation not supported for the key's value type",
+		Code:    codes.FailedPrecondition,
+	}
 	ErrKeyNotFound = KQError{
 		Message: "Key not found",
 		Code:    codes.NotFound,
@@ -197,6 +204,26 @@ var (
 		Message: "client_id provided does not match key",
 		Code:    codes.PermissionDenied,
 	}
+	ErrSharedLocked = KQError{
+		Message: "Key has shared locks held by other clients",
+		Code:    codes.PermissionDenied,
+	}
+	ErrStaleFencingToken = KQError{
+		Message: "fencing token is older than the latest issued for the key",
+		Code:    codes.FailedPrecondition,
+	}
+	ErrLockQueued = KQError{
+		Message: "Other clients are waiting to lock the key",
+		Code:    codes.PermissionDenied,
+	}
+	ErrLockWaitTimeout = KQError{
+		Message: "timed out waiting for lock",
+		Code:    codes.DeadlineExceeded,
+	}
+	ErrInvalidWaitTimeout = KQError{
+		Message: "invalid wait_timeout",
+		Code:    codes.InvalidArgument,
+	}
 	ErrInvalidLockDuration = KQError{
 		Message: "invalid lock Duration",
 		Code:    codes.OutOfRange,
@@ -213,6 +240,30 @@ var (
 		Message: fmt.Sprintf("Key cannot begin with '%s'", ReservedKeyPrefix),
 		Code:    codes.InvalidArgument,
 	}
+	ErrInvalidWriteMode = KQError{
+		Message: "invalid write mode",
+		Code:    codes.InvalidArgument,
+	}
+	ErrMissingExpectedVersion = KQError{
+		Message: "version or hash required",
+		Code:    codes.InvalidArgument,
+	}
+	ErrEmptyTxn = KQError{
+		Message: "no operations provided",
+		Code:    codes.InvalidArgument,
+	}
+	ErrNotAnInteger = KQError{
+		Message: "value is not an integer",
+		Code:    codes.FailedPrecondition,
+	}
+	ErrIntegerOverflow = KQError{
+		Message: "increment would overflow value",
+		Code:    codes.OutOfRange,
+	}
+	ErrSameSourceAndDestination = KQError{
+		Message: "source and destination keys must be different",
+		Code:    codes.InvalidArgument,
+	}
 )
 
 // Server is the main server struct. It should be initialized
@@ -228,6 +279,33 @@ type Server struct {
 	// locks is a map of key to kvLock values
 	locks map[string]*kvLock
 
+	// sharedLocks is a map of key to the shared kvLock values held on
+	// it, by client ID. A key won't have both shared and exclusive locks.
+	sharedLocks map[string]map[string]*kvLock
+
+	// fencingTokens is a map of key to the latest fencing token issued
+	// for a lock on it, removed when the key is deleted
+	fencingTokens map[string]uint64
+
+	// fencingToken is the last fencing token issued, for any key
+	fencingToken uint64
+
+	// lockWaiters is a map of key to the Lock requests waiting for
+	// another client to release a lock on it, in the order received
+	lockWaiters map[string][]*lockWaiter
+
+	// semaphores is a map of key to the semaphore held on it. A semaphore
+	// is removed when its last permit is released.
+	semaphores map[string]*semaphore
+
+	// listWaiters is a map of key to the ListPop requests waiting for a
+	// value to be pushed to the list, in the order received
+	listWaiters map[string][]*listWaiter
+
+	// leases is a map of lease ID to the lease granted to a client by
+	// Register, which expires unless renewed by KeepAlive
+	leases map[string]*lease
+
 	// reapers track keys that are set to expire after a certain
 	// amount of time
 	reapers map[string]*reaper
@@ -242,6 +320,20 @@ type Server struct {
 	// and key deletions
 	keyStats map[string]*keyLifetimeMetric
 
+	// namespaces tracks namespaceMetrics for each non-default namespace
+	namespaces map[string]*namespaceMetrics
+
+	// schemas maps namespaced key prefixes to the JSON Schemas values
+	// set on keys with the prefix must validate against
+	schemas map[string]*keySchema
+
+	// keys holds the encryption keys used for snapshots (and to decrypt
+	// values), and is nil if encryption isn't configured
+	keys *keyring
+	// valueKeys is the same as keys if values are kept encrypted in
+	// memory (EncryptionConfig.Values), otherwise nil
+	valueKeys *keyring
+
 	// The mutexes here are used to protect the maps above. They should generally
 	// be locked in the order below, starting from whichever mutex is being
 	// used. Doing any of these backwards may result in a deadlock.
@@ -252,6 +344,9 @@ type Server struct {
 	reaperMu  sync.RWMutex // reaperMu is a mutex for reapers
 	cmu       sync.RWMutex // cmu is a mutex specific to clientInfo
 	hmu       sync.RWMutex // hmu is a mutex specific to history
+	nsMu      sync.RWMutex // nsMu is a mutex for namespaces
+	leaseMu   sync.RWMutex // leaseMu is a mutex for leases
+	schemaMu  sync.RWMutex // schemaMu is a mutex for schemas
 
 	// logger is the main logger for the server. Set this via Config
 	logger *slog.Logger
@@ -263,6 +358,15 @@ type Server struct {
 	// closed as the final step of Stop
 	events chan Event
 
+	// emitMu is read-locked while sending a single event on events,
+	// and write-locked while sending the events from a transaction,
+	// so that events from the same transaction are delivered together
+	emitMu sync.RWMutex
+
+	// txn is set while a transaction is being applied, to collect
+	// the events it emits (see Server.Txn)
+	txn atomic.Pointer[txnEvents]
+
 	// eventStream handles publishing of events to subscribers other
 	// than snapshotter and the event logger
 	eventStream *eventStream
@@ -303,6 +407,11 @@ type Server struct {
 	// history)
 	totalSize atomic.Uint64
 
+	// storedSize is the current total size of key values as stored, which
+	// is smaller than totalSize if any are compressed (does not include
+	// history)
+	storedSize atomic.Uint64
+
 	// metrics for KeyEvent types seen by the event loop
 	numEventValueAccessed   atomic.Uint64 // numEventValueAccessed tracks Accessed
 	numEventCreated         atomic.Uint64 // numEventCreated tracks Created
@@ -314,6 +423,7 @@ type Server struct {
 	numEventExpunged        atomic.Uint64 // numEventExpunged tracks Expunged
 	numEventLifespanSet     atomic.Uint64 // numEventLifespanSet tracks LifespanSet
 	numEventLifespanRenewed atomic.Uint64 // numEventLifespanRenewed tracks LifespanRenewed
+	numEventLifespanCleared atomic.Uint64 // numEventLifespanCleared tracks LifespanCleared
 
 	// numEventSubscribers tracks the number of subscribers to the event
 	// (this reflects the eventWorker count, generally)
@@ -336,6 +446,10 @@ type Server struct {
 	// numLocks tracks the number of locks currently held
 	numLocks atomic.Uint64
 
+	// numLockWaiters tracks the number of Lock requests currently
+	// waiting in a key's queue
+	numLockWaiters atomic.Uint64
+
 	// clientIDs tracks the number of client IDs tracked by clientInfo
 	clientIDs atomic.Uint64
 
@@ -382,6 +496,24 @@ func New(cfg *Config) (*Server, error) {
 		return nil, fmt.Errorf("eager_prune_to must be less than eager_prune_at")
 	}
 
+	if err := validateNamespaceConfigs(cfg.Namespaces); err != nil {
+		return nil, err
+	}
+
+	schemas, err := compileSchemaConfigs(cfg.Schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := newKeyring(cfg.Encryption)
+	if err != nil {
+		return nil, err
+	}
+	var valueKeys *keyring
+	if cfg.Encryption.Values {
+		valueKeys = keys
+	}
+
 	if cfg.Logger == nil {
 		var logger *slog.Logger
 		var handler slog.Handler
@@ -445,18 +577,28 @@ func New(cfg *Config) (*Server, error) {
 	}
 
 	srv := &Server{
-		store:        make(map[string]*keyValue),
-		logger:       cfg.Logger,
-		cfg:          cfg,
-		locks:        make(map[string]*kvLock),
-		reapers:      make(map[string]*reaper),
-		events:       make(chan Event),
-		keyStats:     map[string]*keyLifetimeMetric{},
-		clientInfo:   map[string]*ClientInfo{},
-		eagerPruneCh: make(chan string, 1),
-		tracer:       otel.Tracer(cfg.TracerName),
-		onStart:      make(chan struct{}, 1),
-		shutdown:     make(chan struct{}, 1),
+		store:         make(map[string]*keyValue),
+		logger:        cfg.Logger,
+		cfg:           cfg,
+		locks:         make(map[string]*kvLock),
+		sharedLocks:   make(map[string]map[string]*kvLock),
+		fencingTokens: make(map[string]uint64),
+		lockWaiters:   make(map[string][]*lockWaiter),
+		semaphores:    make(map[string]*semaphore),
+		leases:        make(map[string]*lease),
+		listWaiters:   make(map[string][]*listWaiter),
+		reapers:       make(map[string]*reaper),
+		events:        make(chan Event),
+		keyStats:      map[string]*keyLifetimeMetric{},
+		namespaces:    map[string]*namespaceMetrics{},
+		schemas:       schemas,
+		keys:          keys,
+		valueKeys:     valueKeys,
+		clientInfo:    map[string]*ClientInfo{},
+		eagerPruneCh:  make(chan string, 1),
+		tracer:        otel.Tracer(cfg.TracerName),
+		onStart:       make(chan struct{}, 1),
+		shutdown:      make(chan struct{}, 1),
 	}
 	srv.cfgMu.Lock()
 	defer srv.cfgMu.Unlock()
@@ -501,7 +643,11 @@ func New(cfg *Config) (*Server, error) {
 
 		serverOpts = append(
 			serverOpts,
-			grpc.UnaryInterceptor(ClientIDInterceptor(srv)),
+			grpc.ChainUnaryInterceptor(
+				ClientIDInterceptor(srv),
+				NamespaceInterceptor(srv),
+			),
+			grpc.StreamInterceptor(NamespaceStreamInterceptor(srv)),
 			grpc.KeepaliveParams(
 				keepalive.ServerParameters{
 					Time:    DefaultKeepaliveTime,
@@ -1103,7 +1249,11 @@ func NewFromLatestSnapshot(
 		),
 	)
 
-	if err = json.Unmarshal(latestSnapshot.Data, srv); err != nil {
+	data, err := srv.keys.decryptSnapshot(latestSnapshot.Data)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, srv); err != nil {
 		return nil, fmt.Errorf("unable to unmarshal snapshot: %w", err)
 	}
 	return srv, nil
@@ -1115,13 +1265,6 @@ func (s *Server) Set(ctx context.Context, in *pb.KeyValue) (
 	*pb.SetResponse,
 	error,
 ) {
-	if in.Key == "" {
-		return nil, ErrEmptyKey
-	}
-	if strings.HasPrefix(strings.ToLower(in.Key), ReservedKeyPrefix) {
-		return nil, ErrReservedKeyPrefix
-	}
-
 	span := trace.SpanFromContext(ctx)
 	span.SetAttributes(attribute.String("key", in.Key))
 
@@ -1133,6 +1276,7 @@ func (s *Server) Set(ctx context.Context, in *pb.KeyValue) (
 			slog.String("key", in.Key),
 			slog.Any("lock_duration", in.LockDuration),
 			slog.Any("lifespan", in.Lifespan),
+			slog.String("mode", in.Mode.String()),
 		),
 	)
 
@@ -1143,52 +1287,178 @@ func (s *Server) Set(ctx context.Context, in *pb.KeyValue) (
 		return nil, ErrReadOnlyServer
 	}
 
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	lockDuration, expireAfter, err := validateSetRequest(cfg, in)
+	if err != nil {
+		return nil, err
+	}
+	in.Key = s.namespacedKey(ctx, in.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clientID := s.ClientID(ctx)
+	return s.setKey(logger, cfg, clientID, in, lockDuration, expireAfter)
+}
+
+// validateSetRequest checks the given request against the provided
+// configuration, returning the requested lock duration and lifespan,
+// if either was provided.
+func validateSetRequest(cfg Config, in *pb.KeyValue) (
+	lockDuration *time.Duration,
+	expireAfter *time.Duration,
+	err error,
+) {
+	if in.Key == "" {
+		return nil, nil, ErrEmptyKey
+	}
+	if strings.HasPrefix(strings.ToLower(in.Key), ReservedKeyPrefix) {
+		return nil, nil, ErrReservedKeyPrefix
+	}
+
+	if err = validateWriteMode(in.Mode, in.Version, in.Hash); err != nil {
+		return nil, nil, err
+	}
+
 	if cfg.MaxKeyLength > 0 && uint64(len(in.Key)) > cfg.MaxKeyLength {
 		slog.Info(
 			"key too long",
 			slog.String("key", in.Key),
 			slog.Uint64("max", cfg.MaxKeyLength),
 		)
-		return nil, ErrKeyTooLong
+		return nil, nil, ErrKeyTooLong
 	}
 
 	size := uint64(len(in.Value))
 	if cfg.MaxValueSize > 0 && size > cfg.MaxValueSize {
-		return nil, ErrValueTooLarge
+		return nil, nil, ErrValueTooLarge
 	}
 
-	var lockDuration *time.Duration
-	var expireAfter *time.Duration
+	if err = validateLabels(in.Labels); err != nil {
+		return nil, nil, err
+	}
 
 	// Validate any explicitly provided lock Duration
 	if in.LockDuration != nil {
 		d := in.LockDuration.AsDuration()
 		if d > cfg.MaxLockDuration {
-			return nil, ErrLockDurationTooLong
+			return nil, nil, ErrLockDurationTooLong
 		}
 		if d == 0 || d < cfg.MinLockDuration {
-			return nil, ErrInvalidLockDuration
+			return nil, nil, ErrInvalidLockDuration
 		}
 		lockDuration = &d
 	}
 	if in.Lifespan != nil {
 		e := in.Lifespan.AsDuration()
 		if e == 0 || e < cfg.MinLifespan {
-			return nil, KQError{
+			return nil, nil, KQError{
 				Message: "invalid expire_after",
 				Code:    codes.InvalidArgument,
 			}
 		}
 		expireAfter = &e
 	}
+	if in.ExpireAt != nil {
+		if in.Lifespan != nil {
+			return nil, nil, KQError{
+				Message: "only one of lifespan or expire_at may be provided",
+				Code:    codes.InvalidArgument,
+			}
+		}
+		e := time.Until(in.ExpireAt.AsTime())
+		if e <= 0 || e < cfg.MinLifespan {
+			return nil, nil, KQError{
+				Message: "invalid expire_at",
+				Code:    codes.InvalidArgument,
+			}
+		}
+		expireAfter = &e
+	}
+	return lockDuration, expireAfter, nil
+}
 
-	var kvInfo *keyValue
-
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// checkSet returns an error if the given client can't currently set
+// the key in the given request: if it's locked by another client, its
+// fencing token is stale, its [pb.WriteMode] condition isn't met, or a
+// new key would exceed [Config.MaxNumberOfKeys]. Server.mu must be held
+// by the caller.
+func (s *Server) checkSet(
+	cfg Config,
+	clientID string,
+	in *pb.KeyValue,
+) error {
 	kvInfo, exists := s.store[in.Key]
 
-	clientID := s.ClientID(ctx)
+	s.lockMu.RLock()
+	keyLock, alreadyLocked := s.locks[in.Key]
+	sharedLocked := s.sharedLockedByOthers(in.Key, clientID)
+	fencingErr := s.checkFencingToken(in.Key, in.FencingToken)
+	s.lockMu.RUnlock()
+	if exists {
+		if alreadyLocked && clientID != keyLock.ClientID {
+			return ErrWrongUnlockToken
+		}
+		if sharedLocked {
+			return ErrSharedLocked
+		}
+	}
+	if fencingErr != nil {
+		return fencingErr
+	}
+	if err := s.checkLease(in.LeaseId, clientID); err != nil {
+		return err
+	}
+
+	if err := checkWriteCondition(
+		in.Key,
+		kvInfo,
+		in.Mode,
+		in.Version,
+		in.Hash,
+	); err != nil {
+		return err
+	}
+
+	if !exists {
+		currentCt := s.numKeys.Load()
+		maxKeys := cfg.MaxNumberOfKeys
+		if maxKeys > 0 && currentCt >= maxKeys {
+			s.logger.Warn(
+				"number of keys >= maximum, enable eager pruning to automatically make space",
+				"current", currentCt,
+				"max", maxKeys,
+			)
+			return ErrMaxKeysReached
+		}
+		namespace, _ := splitNamespacedKey(in.Key)
+		return s.checkNamespaceMaxKeys(cfg, namespace, 1)
+	}
+	return nil
+}
+
+// setKey creates or updates the key in the given request, which should
+// already have been validated with validateSetRequest. Server.mu must be
+// held by the caller.
+func (s *Server) setKey(
+	logger *slog.Logger,
+	cfg Config,
+	clientID string,
+	in *pb.KeyValue,
+	lockDuration *time.Duration,
+	expireAfter *time.Duration,
+) (*pb.SetResponse, error) {
+	if err := s.checkSet(cfg, clientID, in); err != nil {
+		logger.Info("unable to set key", "error", err)
+		return nil, err
+	}
+	if err := s.checkSchema(in.Key, in.Value); err != nil {
+		logger.Info("value failed schema validation", "error", err)
+		return nil, err
+	}
+
+	size := uint64(len(in.Value))
+	kvInfo, exists := s.store[in.Key]
 
 	// Update an existing key
 	if exists {
@@ -1197,10 +1467,6 @@ func (s *Server) Set(ctx context.Context, in *pb.KeyValue) (
 
 		keyLock, alreadyLocked := s.locks[in.Key]
 
-		if alreadyLocked && clientID != keyLock.ClientID {
-			return nil, ErrWrongUnlockToken
-		}
-
 		// When updating the value, stop any current reaper.
 		// If a lifespan was provided, create a new reaper using
 		// that duration. Otherwise, create a new reaper with
@@ -1228,6 +1494,10 @@ func (s *Server) Set(ctx context.Context, in *pb.KeyValue) (
 			)
 			logger.Info("created new reaper", "reaper", keyReaper)
 		}
+		if in.ExpireAt != nil {
+			expireAt := in.ExpireAt.AsTime()
+			keyReaper.ExpireAt = &expireAt
+		}
 
 		hashChannel := make(chan uint64, 1)
 		go func() {
@@ -1248,18 +1518,42 @@ func (s *Server) Set(ctx context.Context, in *pb.KeyValue) (
 			case alreadyLocked:
 				_ = keyLock.renew(*lockDuration, clientID)
 			default:
+				// checkSet only allows this if the client holds the
+				// only shared lock, which is replaced
+				s.removeSharedLock(in.Key, clientID)
 				keyLock = newKeyLock(s, *lockDuration, in.Key, clientID)
 			}
 		}
 		kvInfo.mu.Lock()
 		defer kvInfo.mu.Unlock()
 
+		if in.LeaseId != "" {
+			if kvInfo.LeaseID != in.LeaseId {
+				s.unbindLease(kvInfo.LeaseID, in.Key, false)
+			}
+			kvInfo.LeaseID = in.LeaseId
+			s.bindLease(in.LeaseId, in.Key, false)
+		}
+
+		labelsChanged := (len(in.Labels) > 0 || in.ClearLabels) &&
+			!maps.Equal(kvInfo.Labels, in.Labels)
+		if labelsChanged {
+			kvInfo.Labels = maps.Clone(in.Labels)
+		}
+
 		// Check back in on our hash function, and only consider it
 		// an updated value if the hash has changed, or there's no
 		// hash function set
 		newHash := <-hashChannel
-		switch newHash {
-		case kvInfo.Hash:
+		switch {
+		case newHash == kvInfo.Hash && labelsChanged:
+			// The version only changes with the value, but watchers
+			// may be selecting on labels
+			s.logger.Info("updated labels", kvLogKey, kvInfo)
+			now := time.Now()
+			defer s.emit(in.Key, Updated, clientID, &now)
+			kvInfo.Updated = now
+		case newHash == kvInfo.Hash:
 			s.logger.Info("no change to value", kvLogKey, kvInfo)
 		default:
 			s.logger.Info("updated value", kvLogKey, kvInfo)
@@ -1268,11 +1562,20 @@ func (s *Server) Set(ctx context.Context, in *pb.KeyValue) (
 
 			s.totalSize.Add(^(kvInfo.Size - 1))
 			s.totalSize.Add(size)
+			s.storedSize.Add(^(kvInfo.storedSize() - 1))
+			s.resizeNamespaceKey(in.Key, kvInfo.Size, size)
 			kvInfo.Size = size
 			kvInfo.Updated = now
-			kvInfo.Value = in.Value
+			kvInfo.setValue(in.Value, cfg.CompressionThreshold, s.valueKeys)
+			s.storedSize.Add(kvInfo.storedSize())
 			kvInfo.Version++
 			kvInfo.Hash = newHash
+			// Values of other types aren't hashed, so are always replaced
+			kvInfo.Type = pb.ValueType_STRING
+			kvInfo.List = nil
+			kvInfo.Fields = nil
+			kvInfo.Members = nil
+			kvInfo.Scores = nil
 			if cfg.RevisionLimit != 0 {
 				s.hmu.Lock()
 				defer s.hmu.Unlock()
@@ -1280,24 +1583,18 @@ func (s *Server) Set(ctx context.Context, in *pb.KeyValue) (
 			}
 		}
 
-		return &pb.SetResponse{Success: true}, nil
+		return &pb.SetResponse{
+			Success: true,
+			Version: kvInfo.Version,
+			Hash:    kvInfo.Hash,
+		}, nil
 	}
 
 	// Create a new key
 	currentCt := s.numKeys.Load()
 	eagerPruneAt := cfg.EagerPruneAt
-	maxKeys := cfg.MaxNumberOfKeys
 
 	switch {
-	case maxKeys > 0 && currentCt >= maxKeys:
-		overLimit := currentCt - eagerPruneAt
-		s.logger.Warn(
-			"number of keys >= maximum, enable eager pruning to automatically make space",
-			"current", currentCt,
-			"max", maxKeys,
-			"over_limit", overLimit,
-		)
-		return nil, ErrMaxKeysReached
 	case eagerPruneAt > 0 && currentCt >= eagerPruneAt:
 		overLimit := currentCt - eagerPruneAt
 		s.logger.Warn(
@@ -1357,12 +1654,16 @@ func (s *Server) Set(ctx context.Context, in *pb.KeyValue) (
 	s.logger.Debug("most recent version seen", "key_version", version)
 
 	kvInfo = newKeyValue(in.Key, in.Value, in.ContentType, clientID)
+	kvInfo.setValue(in.Value, cfg.CompressionThreshold, s.valueKeys)
 	kvInfo.Version = version
+	kvInfo.Labels = maps.Clone(in.Labels)
+	kvInfo.LeaseID = in.LeaseId
+	s.bindLease(in.LeaseId, in.Key, false)
 
 	_ = s.addKeyValueSnapshot(kvInfo, cfg.RevisionLimit)
 	s.addKeyValueInfo(kvInfo, size)
 
-	if in.LockDuration != nil {
+	if lockDuration != nil {
 		s.lockMu.Lock()
 		lock := newKeyLock(s, *lockDuration, in.Key, clientID)
 		logger.Info("created new lock", "key_lock", lock)
@@ -1377,6 +1678,10 @@ func (s *Server) Set(ctx context.Context, in *pb.KeyValue) (
 			in.Key,
 			clientID,
 		)
+		if in.ExpireAt != nil {
+			expireAt := in.ExpireAt.AsTime()
+			keyReaper.ExpireAt = &expireAt
+		}
 		logger.Info("created new reaper", "reaper", keyReaper)
 		s.reaperMu.Unlock()
 	}
@@ -1393,6 +1698,8 @@ func (s *Server) Set(ctx context.Context, in *pb.KeyValue) (
 	return &pb.SetResponse{
 		Success: true,
 		IsNew:   true,
+		Version: kvInfo.Version,
+		Hash:    kvInfo.Hash,
 	}, nil
 }
 
@@ -1406,6 +1713,7 @@ func (s *Server) Get(ctx context.Context, in *pb.Key) (
 
 	logger := s.requestLogger(ctx)
 	logger.Info("getting key", slog.String("key", in.Key))
+	in.Key = s.namespacedKey(ctx, in.Key)
 
 	s.mu.RLock()
 	kvInfo := s.getKey(in.Key)
@@ -1429,9 +1737,17 @@ func (s *Server) Get(ctx context.Context, in *pb.Key) (
 	kvInfo.mu.RLock()
 	s.mu.RUnlock()
 	defer kvInfo.mu.RUnlock()
+	if kvInfo.Type != pb.ValueType_STRING {
+		return nil, ErrWrongType
+	}
+	value, err := kvInfo.rawValue(s.keys)
+	if err != nil {
+		logger.Error("unable to get value", "error", err)
+		return nil, err
+	}
 	t := time.Now()
 	s.emit(in.Key, Accessed, clientID, &t)
-	return &pb.GetResponse{Value: kvInfo.Value}, nil
+	return &pb.GetResponse{Value: value}, nil
 }
 
 // Lock locks a key, preventing other clients from setting/reading it
@@ -1439,13 +1755,6 @@ func (s *Server) Lock(
 	ctx context.Context,
 	in *pb.LockRequest,
 ) (*pb.LockResponse, error) {
-	if in.Key == "" {
-		return nil, ErrEmptyKey
-	}
-	if strings.HasPrefix(strings.ToLower(in.Key), ReservedKeyPrefix) {
-		return nil, ErrReservedKeyPrefix
-	}
-
 	span := trace.SpanFromContext(ctx)
 	span.SetAttributes(attribute.String("key", in.Key))
 
@@ -1453,35 +1762,169 @@ func (s *Server) Lock(
 	clientID := s.ClientID(ctx)
 	logger.Info("requesting lock", slog.String("key", in.Key))
 
+	// cfgMu isn't held for the duration of the request, as it may
+	// wait for the lock
 	s.cfgMu.RLock()
-	defer s.cfgMu.RUnlock()
 	cfg := *s.cfg
+	s.cfgMu.RUnlock()
 
 	if cfg.Readonly {
 		return nil, ErrReadOnlyServer
 	}
 
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	lockDuration, err := validateLockRequest(cfg, in)
+	if err != nil {
+		return nil, err
+	}
+	var waitTimeout time.Duration
+	if in.WaitTimeout != nil {
+		waitTimeout = in.WaitTimeout.AsDuration()
+		if waitTimeout < 0 {
+			return nil, ErrInvalidWaitTimeout
+		}
+	}
+	in.Key = s.namespacedKey(ctx, in.Key)
+
+	// Only need a write lock if we're potentially adding a new key from
+	// this request
+	s.mu.Lock()
+	rv, err := s.lockKey(logger, cfg, clientID, in, lockDuration)
+	if waitTimeout == 0 || status.Code(err) != codes.PermissionDenied {
+		s.mu.Unlock()
+		return rv, err
+	}
+
+	// Queue the request while still holding mu, so the current lock
+	// can't be released before we're waiting for it
+	s.lockMu.Lock()
+	w := s.enqueueLockWaiter(in.Key, clientID)
+	s.lockMu.Unlock()
+	s.mu.Unlock()
+
+	return s.waitForLock(
+		ctx,
+		logger,
+		cfg,
+		clientID,
+		in,
+		lockDuration,
+		waitTimeout,
+		w,
+		nil,
+	)
+}
+
+// validateLockRequest checks the given request against the provided
+// configuration, returning the requested lock duration
+func validateLockRequest(cfg Config, in *pb.LockRequest) (
+	time.Duration,
+	error,
+) {
+	if in.Key == "" {
+		return 0, ErrEmptyKey
+	}
+	if strings.HasPrefix(strings.ToLower(in.Key), ReservedKeyPrefix) {
+		return 0, ErrReservedKeyPrefix
+	}
+
 	if in.CreateIfMissing && cfg.MaxKeyLength > 0 && uint64(len(in.Key)) > cfg.MaxKeyLength {
-		return nil, ErrKeyTooLong
+		return 0, ErrKeyTooLong
 	}
 
 	if in.Duration == nil {
-		return nil, ErrInvalidLockDuration
+		return 0, ErrInvalidLockDuration
 	}
-	var lockDuration time.Duration
-	lockDuration = in.Duration.AsDuration()
+	lockDuration := in.Duration.AsDuration()
 
-	if lockDuration > s.cfg.MaxLockDuration {
-		return nil, ErrLockDurationTooLong
+	if lockDuration > cfg.MaxLockDuration {
+		return 0, ErrLockDurationTooLong
 	}
-	if lockDuration == 0 || lockDuration < s.cfg.MinLockDuration {
-		return nil, ErrInvalidLockDuration
+	if lockDuration == 0 || lockDuration < cfg.MinLockDuration {
+		return 0, ErrInvalidLockDuration
+	}
+	return lockDuration, nil
+}
+
+// checkLock returns an error if the given client can't currently lock
+// the key in the given request: if it's locked by another client, or
+// doesn't exist (and `create_if_missing` wasn't set, or creating it would
+// exceed [Config.MaxNumberOfKeys]). Server.mu must be held by the caller.
+func (s *Server) checkLock(
+	cfg Config,
+	clientID string,
+	in *pb.LockRequest,
+) error {
+	if err := s.checkLease(in.LeaseId, clientID); err != nil {
+		return err
+	}
+	if _, exists := s.store[in.Key]; exists {
+		s.lockMu.RLock()
+		keyLock, alreadyLocked := s.locks[in.Key]
+		sharedLocked := s.sharedLockedByOthers(in.Key, clientID)
+		queued := s.lockQueuedAhead(in.Key, clientID)
+		s.lockMu.RUnlock()
+		if alreadyLocked && clientID != keyLock.ClientID {
+			return KQError{
+				Message: "locked by another client",
+				Code:    codes.PermissionDenied,
+			}
+		}
+		// Exclusive locks are only granted once other clients have
+		// released their shared locks
+		if sharedLocked && in.Mode == pb.LockMode_EXCLUSIVE {
+			return ErrSharedLocked
+		}
+		if queued {
+			return ErrLockQueued
+		}
+		return nil
+	}
+
+	if !in.CreateIfMissing {
+		return ErrKeyNotFound
+	}
+	// The key would be created with an empty value
+	if err := s.checkSchema(in.Key, nil); err != nil {
+		return err
+	}
+	return s.checkCreateKey(cfg, in.Key)
+}
+
+// checkCreateKey returns an error if creating the given key would exceed
+// [Config.MaxNumberOfKeys], or the maximum for its namespace. Server.mu
+// must be held by the caller.
+func (s *Server) checkCreateKey(cfg Config, key string) error {
+	maxKeys := cfg.MaxNumberOfKeys
+	currentCt := s.numKeys.Load()
+	if maxKeys > 0 && currentCt >= maxKeys {
+		overLimit := currentCt - maxKeys
+		s.logger.Warn(
+			"number of keys >= maximum, enable eager pruning to automatically make space",
+			"current", currentCt,
+			"max", maxKeys,
+			"over_limit", overLimit,
+		)
+		return ErrMaxKeysReached
+	}
+	namespace, _ := splitNamespacedKey(key)
+	return s.checkNamespaceMaxKeys(cfg, namespace, 1)
+}
+
+// lockKey locks (or renews the lock on) the key in the given request,
+// which should already have been validated with validateLockRequest.
+// Server.mu must be held by the caller.
+func (s *Server) lockKey(
+	logger *slog.Logger,
+	cfg Config,
+	clientID string,
+	in *pb.LockRequest,
+	lockDuration time.Duration,
+) (*pb.LockResponse, error) {
+	if err := s.checkLock(cfg, clientID, in); err != nil {
+		return nil, err
 	}
 
-	// Only need a write lock if we're potentially adding a new key from
-	// this request
-	s.mu.Lock()
-	defer s.mu.Unlock()
 	var kvInfo *keyValue
 
 	kvInfo, ok := s.store[in.Key]
@@ -1494,89 +1937,104 @@ func (s *Server) Lock(
 
 		keyLock, alreadyLocked := s.locks[in.Key]
 		switch {
+		case in.Mode == pb.LockMode_SHARED:
+			keyLock = s.lockShared(logger, lockDuration, in.Key, clientID)
 		case alreadyLocked:
-			if clientID != keyLock.ClientID {
-				return nil, KQError{
-					Message: "locked by another client",
-					Code:    codes.PermissionDenied,
-				}
-			}
 			logger.Debug("renewing lock", slog.String("key", in.Key))
 			_ = keyLock.renew(lockDuration, clientID)
 		default:
-			newLock := newKeyLock(s, lockDuration, in.Key, clientID)
+			// If the client holds the only shared lock, it's
+			// replaced by the exclusive lock
+			s.removeSharedLock(in.Key, clientID)
+			keyLock = newKeyLock(s, lockDuration, in.Key, clientID)
 			logger.Info(
 				"lock granted",
-				"key_lock", newLock,
+				"key_lock", keyLock,
 			)
 		}
-		return &pb.LockResponse{Success: true}, nil
-	}
-
-	if !in.CreateIfMissing {
-		return nil, ErrKeyNotFound
+		s.bindLockLease(keyLock, in.LeaseId)
+		return &pb.LockResponse{
+			Success:      true,
+			FencingToken: keyLock.FencingToken,
+		}, nil
 	}
 
 	s.lockMu.Lock()
 	defer s.lockMu.Unlock()
-	maxKeys := cfg.MaxNumberOfKeys
+
+	kvInfo = s.createEmptyKey(cfg, in.Key, clientID, pb.ValueType_STRING)
+	var lock *kvLock
+	switch in.Mode {
+	case pb.LockMode_SHARED:
+		lock = newSharedKeyLock(s, lockDuration, in.Key, clientID)
+	default:
+		lock = newKeyLock(s, lockDuration, in.Key, clientID)
+	}
+	logger.Info(
+		"created key",
+		kvLogKey, kvInfo,
+		"key_lock", lock,
+	)
+	s.bindLockLease(lock, in.LeaseId)
+	return &pb.LockResponse{
+		Success:      true,
+		FencingToken: lock.FencingToken,
+	}, nil
+}
+
+// createEmptyKey creates the given key with an empty value of the given
+// type, which should already have been checked with checkCreateKey.
+// Server.mu must be held by the caller.
+func (s *Server) createEmptyKey(
+	cfg Config,
+	key string,
+	clientID string,
+	valueType pb.ValueType,
+) *keyValue {
 	eagerPruneAt := cfg.EagerPruneAt
 	currentCt := s.numKeys.Load()
 
-	if in.CreateIfMissing && (maxKeys > 0 || eagerPruneAt > 0) {
-		switch {
-		case maxKeys > 0 && currentCt >= maxKeys:
-			overLimit := currentCt - maxKeys
-			s.logger.Warn(
-				"number of keys >= maximum, enable eager pruning to automatically make space",
-				"current", currentCt,
-				"max", maxKeys,
-				"over_limit", overLimit,
-			)
-			return nil, ErrMaxKeysReached
-		case eagerPruneAt > 0 && currentCt >= eagerPruneAt:
-			overLimit := currentCt - eagerPruneAt
-			s.logger.Warn(
-				"number of keys over eager prune limit",
-				"current", currentCt,
-				"eager_prune_at", eagerPruneAt,
-				"over_limit", overLimit,
-			)
-			select {
-			case s.eagerPruneCh <- in.Key:
-				s.logger.Debug("sent eager prune signal")
-			default:
-				// don't wait up
-			}
+	switch {
+	case eagerPruneAt > 0 && currentCt >= eagerPruneAt:
+		overLimit := currentCt - eagerPruneAt
+		s.logger.Warn(
+			"number of keys over eager prune limit",
+			"current", currentCt,
+			"eager_prune_at", eagerPruneAt,
+			"over_limit", overLimit,
+		)
+		select {
+		case s.eagerPruneCh <- key:
+			s.logger.Debug("sent eager prune signal")
 		default:
-			s.logger.Debug(
-				"key counts",
-				"current",
-				currentCt,
-				"max",
-				cfg.MaxNumberOfKeys,
-			)
-
+			// don't wait up
 		}
+	default:
+		s.logger.Debug(
+			"key counts",
+			"current",
+			currentCt,
+			"max",
+			cfg.MaxNumberOfKeys,
+		)
 	}
 
-	d := in.Duration.AsDuration()
-	kvInfo = newKeyValue(in.Key, nil, "", clientID)
+	kvInfo := newKeyValue(key, nil, "", clientID)
+	kvInfo.Type = valueType
+	kvInfo.Encrypted = s.valueKeys != nil && encryptsElements(valueType)
 
-	s.hmu.Lock()
-	defer s.hmu.Unlock()
-	_ = s.addKeyValueSnapshot(kvInfo, cfg.RevisionLimit)
+	// Revisions are only kept for STRING values, and other types
+	// aren't hashed
+	if valueType == pb.ValueType_STRING {
+		s.hmu.Lock()
+		defer s.hmu.Unlock()
+		_ = s.addKeyValueSnapshot(kvInfo, cfg.RevisionLimit)
+	} else {
+		kvInfo.Hash = 0
+	}
 
 	s.addKeyValueInfo(kvInfo, 0)
-	lock := newKeyLock(s, d, in.Key, clientID)
-	logger.Info(
-		"created key",
-		kvLogKey, kvInfo,
-		"key_lock", lock,
-	)
-	return &pb.LockResponse{
-		Success: true,
-	}, nil
+	return kvInfo
 }
 
 func (s *Server) Unlock(
@@ -1603,6 +2061,7 @@ func (s *Server) Unlock(
 	if cfg.Readonly {
 		return nil, ErrReadOnlyServer
 	}
+	in.Key = s.namespacedKey(ctx, in.Key)
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -1617,20 +2076,37 @@ func (s *Server) Unlock(
 
 	keyLock, exists := s.locks[in.Key]
 	if !exists {
+		if len(s.sharedLocks[in.Key]) == 0 {
+			return &pb.UnlockResponse{Success: true}, nil
+		}
+		if !s.removeSharedLock(in.Key, clientID) {
+			return nil, ErrWrongUnlockToken
+		}
+		now := time.Now()
+		s.emit(in.Key, Unlocked, clientID, &now)
 		return &pb.UnlockResponse{Success: true}, nil
 	}
 
 	if clientID != keyLock.ClientID {
 		return nil, ErrWrongUnlockToken
 	}
-	delete(s.locks, in.Key)
+	s.releaseLock(keyLock, clientID)
+
+	return &pb.UnlockResponse{Success: true}, nil
+}
+
+// releaseLock removes the given (exclusive) lock, on behalf of the
+// given client, and notifies the next client waiting for the lock.
+// Server.lockMu must be held by the caller.
+func (s *Server) releaseLock(keyLock *kvLock, clientID string) {
+	delete(s.locks, keyLock.Key)
 	_ = keyLock.t.Stop()
+	s.unbindLease(keyLock.LeaseID, keyLock.Key, true)
 	s.numLocks.Add(decrementUint64)
+	s.notifyLockWaiter(keyLock.Key)
 
 	now := time.Now()
-	s.emit(in.Key, Unlocked, clientID, &now)
-
-	return &pb.UnlockResponse{Success: true}, nil
+	s.emit(keyLock.Key, Unlocked, clientID, &now)
 }
 
 // Delete deletes a key from the store
@@ -1648,8 +2124,8 @@ func (s *Server) Delete(ctx context.Context, in *pb.DeleteRequest) (
 		slog.String("key", in.Key),
 	)
 
-	if strings.HasPrefix(strings.ToLower(in.Key), ReservedKeyPrefix) {
-		return nil, ErrReservedKeyPrefix
+	if err := validateDeleteRequest(in); err != nil {
+		return nil, err
 	}
 
 	s.cfgMu.RLock()
@@ -1658,25 +2134,87 @@ func (s *Server) Delete(ctx context.Context, in *pb.DeleteRequest) (
 	if cfg.Readonly {
 		return nil, ErrReadOnlyServer
 	}
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	in.Key = s.namespacedKey(ctx, in.Key)
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	return s.deleteKeyValue(logger, cfg, clientID, in)
+}
+
+// validateDeleteRequest returns an error if the given request is invalid,
+// regardless of the current state of the key
+func validateDeleteRequest(in *pb.DeleteRequest) error {
+	if strings.HasPrefix(strings.ToLower(in.Key), ReservedKeyPrefix) {
+		return ErrReservedKeyPrefix
+	}
+	if in.Mode == pb.WriteMode_CREATE_ONLY {
+		return ErrInvalidWriteMode
+	}
+	return validateWriteMode(in.Mode, in.Version, in.Hash)
+}
+
+// checkDelete returns an error if the given client can't currently delete
+// the key in the given request: if it doesn't exist, is locked by another
+// client, its fencing token is stale, or its [pb.WriteMode] condition
+// isn't met. Server.mu must be held by the caller.
+func (s *Server) checkDelete(clientID string, in *pb.DeleteRequest) error {
 	kvInfo := s.getKey(in.Key)
 	if kvInfo == nil {
-		return &pb.DeleteResponse{Deleted: false}, ErrKeyNotFound
+		if in.Mode == pb.WriteMode_IF_VERSION_MATCHES {
+			return checkWriteCondition(
+				in.Key,
+				nil,
+				in.Mode,
+				in.Version,
+				in.Hash,
+			)
+		}
+		return ErrKeyNotFound
 	}
 
-	s.lockMu.Lock()
-	defer s.lockMu.Unlock()
-
+	s.lockMu.RLock()
 	keyLock := s.locks[in.Key]
+	sharedLocked := s.sharedLockedByOthers(in.Key, clientID)
+	fencingErr := s.checkFencingToken(in.Key, in.FencingToken)
+	s.lockMu.RUnlock()
 	if keyLock != nil && clientID != keyLock.ClientID {
-		logger.Info(
-			"cannot delete locked key", "key", in.Key,
-		)
-		return &pb.DeleteResponse{Deleted: false}, ErrWrongUnlockToken
+		return ErrWrongUnlockToken
 	}
+	if sharedLocked {
+		return ErrSharedLocked
+	}
+	if fencingErr != nil {
+		return fencingErr
+	}
+
+	return checkWriteCondition(
+		in.Key,
+		kvInfo,
+		in.Mode,
+		in.Version,
+		in.Hash,
+	)
+}
+
+// deleteKeyValue deletes the key in the given request, which should
+// already have been validated with validateDeleteRequest. Server.mu must
+// be held by the caller.
+func (s *Server) deleteKeyValue(
+	logger *slog.Logger,
+	cfg Config,
+	clientID string,
+	in *pb.DeleteRequest,
+) (*pb.DeleteResponse, error) {
+	if err := s.checkDelete(clientID, in); err != nil {
+		logger.Info("unable to delete key", "key", in.Key, "error", err)
+		return &pb.DeleteResponse{Deleted: false}, err
+	}
+	kvInfo := s.store[in.Key]
+
+	s.lockMu.Lock()
+	defer s.lockMu.Unlock()
 
 	s.reaperMu.Lock()
 	defer s.reaperMu.Unlock()
@@ -1684,7 +2222,7 @@ func (s *Server) Delete(ctx context.Context, in *pb.DeleteRequest) (
 	kvInfo.mu.Lock()
 	defer kvInfo.mu.Unlock()
 
-	s.deleteKey(in.Key, clientID, false)
+	s.deleteKey(in.Key, clientID, Deleted)
 	if !cfg.KeepKeyHistoryAfterDelete {
 		s.hmu.Lock()
 		defer s.hmu.Unlock()
@@ -1707,6 +2245,7 @@ func (s *Server) Inspect(
 		"getting key info",
 		slog.String("key", in.Key),
 	)
+	in.Key = s.namespacedKey(ctx, in.Key)
 
 	s.mu.RLock()
 
@@ -1718,6 +2257,13 @@ func (s *Server) Inspect(
 
 	s.lockMu.RLock()
 	keyLock, locked := s.locks[in.Key]
+	lockHolders := s.lockHolders(in.Key)
+	keyLocked := s.keyLocked(in.Key)
+	lockQueueDepth := uint64(len(s.lockWaiters[in.Key]))
+	var semaphoreInfo *pb.SemaphoreInfo
+	if sem, ok := s.semaphores[in.Key]; ok {
+		semaphoreInfo = sem.info()
+	}
 	if locked && in.IncludeValue {
 		clientID := s.ClientID(ctx)
 		if clientID != keyLock.ClientID {
@@ -1735,18 +2281,27 @@ func (s *Server) Inspect(
 
 	resp := &pb.InspectResponse{}
 	if hasExpiry {
+		expiresAt := lifespan.expiresAt()
 		resp.Lifespan = durationpb.New(lifespan.Lifespan)
 		resp.LifespanSet = timestamppb.New(lifespan.LifespanSet)
+		resp.ExpiresAt = timestamppb.New(expiresAt)
+		resp.Remaining = durationpb.New(time.Until(expiresAt))
 	}
 	s.reaperMu.RUnlock()
 	kvInfo.mu.RLock()
 
-	resp.Key = kvInfo.Key
+	resp.Key = keyName(kvInfo.Key)
+	resp.Labels = maps.Clone(kvInfo.Labels)
 	resp.Hash = kvInfo.Hash
 	resp.Version = kvInfo.Version
 	resp.Size = kvInfo.Size
 	resp.ContentType = kvInfo.ContentType
-	resp.Locked = &locked
+	resp.LeaseId = kvInfo.LeaseID
+	resp.ValueType = kvInfo.Type
+	resp.Locked = &keyLocked
+	resp.LockHolders = lockHolders
+	resp.LockQueueDepth = lockQueueDepth
+	resp.Semaphore = semaphoreInfo
 
 	if !kvInfo.Created.IsZero() {
 		resp.Created = timestamppb.New(kvInfo.Created)
@@ -1754,8 +2309,15 @@ func (s *Server) Inspect(
 	if !kvInfo.Updated.IsZero() {
 		resp.Updated = timestamppb.New(kvInfo.Updated)
 	}
-	if in.IncludeValue {
-		resp.Value = kvInfo.Value
+	// Lists, hashes and sets don't have a single value to include
+	if in.IncludeValue && kvInfo.Type == pb.ValueType_STRING {
+		value, err := kvInfo.rawValue(s.keys)
+		if err != nil {
+			kvInfo.mu.RUnlock()
+			s.mu.RUnlock()
+			return nil, err
+		}
+		resp.Value = value
 		t := time.Now()
 		defer s.emit(in.Key, Accessed, s.ClientID(ctx), &t)
 	}
@@ -1796,7 +2358,8 @@ func (s *Server) Inspect(
 	return resp, nil
 }
 
-// Pop returns a Key-value pair and deletes the key
+// Pop returns a Key-value pair and deletes the key. As with Get,
+// ErrWrongType is returned for values other than STRING.
 func (s *Server) Pop(ctx context.Context, in *pb.PopRequest) (
 	*pb.GetResponse,
 	error,
@@ -1821,6 +2384,7 @@ func (s *Server) Pop(ctx context.Context, in *pb.PopRequest) (
 	if cfg.Readonly {
 		return nil, ErrReadOnlyServer
 	}
+	in.Key = s.namespacedKey(ctx, in.Key)
 
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -1840,6 +2404,15 @@ func (s *Server) Pop(ctx context.Context, in *pb.PopRequest) (
 		)
 		return nil, ErrLocked
 	}
+	if s.sharedLockedByOthers(in.Key, clientID) {
+		logger.Info(
+			"cannot pop key with shared locks", kvLogKey, kvInfo,
+		)
+		return nil, ErrSharedLocked
+	}
+	if err := s.checkFencingToken(in.Key, in.FencingToken); err != nil {
+		return nil, err
+	}
 
 	s.reaperMu.Lock()
 	defer s.reaperMu.Unlock()
@@ -1847,9 +2420,16 @@ func (s *Server) Pop(ctx context.Context, in *pb.PopRequest) (
 	kvInfo.mu.Lock()
 	defer kvInfo.mu.Unlock()
 
-	getResponse := &pb.GetResponse{Value: kvInfo.Value}
+	if kvInfo.Type != pb.ValueType_STRING {
+		return nil, ErrWrongType
+	}
+	value, err := kvInfo.rawValue(s.keys)
+	if err != nil {
+		return nil, err
+	}
+	getResponse := &pb.GetResponse{Value: value}
 
-	s.deleteKey(in.Key, clientID, false)
+	s.deleteKey(in.Key, clientID, Deleted)
 	s.emit(in.Key, Accessed, clientID, nil)
 	if !cfg.KeepKeyHistoryAfterDelete {
 		s.hmu.Lock()
@@ -1873,6 +2453,7 @@ func (s *Server) Exists(ctx context.Context, in *pb.Key) (
 		"checking if key exists",
 		slog.String("key", in.Key),
 	)
+	in.Key = s.namespacedKey(ctx, in.Key)
 
 	s.mu.RLock()
 	kvInfo := s.getKey(in.Key)
@@ -1886,9 +2467,17 @@ func (s *Server) Exists(ctx context.Context, in *pb.Key) (
 }
 
 // ListKeys returns a list of keys in the store matching the provided
-// pattern, limited to the provided count. If limit=0, all matching keys are
-// returned. If a pattern nor a limit are provided, all keys currently in the
-// store will be returned. This may be expensive, depending on the number of keys.
+// pattern and/or prefix, sorted lexicographically and limited to the
+// provided count. If limit=0, all matching keys are returned. If a pattern
+// nor a limit are provided, all keys currently in the store will be
+// returned. This may be expensive, depending on the number of keys.
+//
+// If there are more matching keys than the limit, the response will
+// include a page token, which can be provided in a subsequent request
+// to continue after the last key returned. Pages aren't a consistent
+// snapshot of the store: each page lists the keys that exist when it's
+// requested, so keys created or deleted between requests may be missed
+// or included.
 func (s *Server) ListKeys(
 	ctx context.Context,
 	req *pb.ListKeysRequest,
@@ -1897,19 +2486,61 @@ func (s *Server) ListKeys(
 	logger.Info(
 		"listing keys",
 		slog.String("pattern", req.Pattern),
+		slog.String("prefix", req.Prefix),
 		slog.Uint64("limit", req.Limit),
 		slog.Bool("include_reserved", req.IncludeReserved),
+		slog.String("start_after", req.StartAfter),
+		slog.String("page_token", req.PageToken),
+		slog.String("selector", req.Selector),
 	)
 
+	startAfter := req.StartAfter
+	if req.PageToken != "" {
+		if startAfter != "" {
+			return nil, KQError{
+				Message: "only one of start_after or page_token may be provided",
+				Code:    codes.InvalidArgument,
+			}
+		}
+		var err error
+		startAfter, err = decodePageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var pattern *regexp.Regexp
+	if req.Pattern != "" {
+		var err error
+		pattern, err = regexp.Compile(req.Pattern)
+		if err != nil {
+			return nil, ErrInvalidKeyPattern
+		}
+	}
+
+	selector, err := parseLabelSelector(req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace := s.Namespace(ctx)
 	limit := int(req.Limit)
 
+	// With a limit, only the first limit+1 matching keys (the extra key
+	// showing there's another page) are kept, rather than sorting
+	// every matching key
 	s.mu.RLock()
+	var allKeys keyHeap
 	if limit == 0 {
-		limit = len(s.store)
+		allKeys = make(keyHeap, 0, len(s.store))
+	} else {
+		allKeys = make(keyHeap, 0, min(limit+1, len(s.store)))
 	}
-
-	allKeys := make([]string, 0, len(s.store))
-	for k := range s.store {
+	for internalKey, kvInfo := range s.store {
+		keyNamespace, k := splitNamespacedKey(internalKey)
+		if keyNamespace != namespace {
+			continue
+		}
 		if !req.IncludeReserved && strings.HasPrefix(
 			strings.ToLower(k),
 			ReservedKeyPrefix,
@@ -1917,38 +2548,75 @@ func (s *Server) ListKeys(
 			logger.Debug("excluding reserved key", "key", k)
 			continue
 		}
-		allKeys = append(allKeys, k)
-	}
-
-	if req.Pattern == "" {
-		s.mu.RUnlock()
-		rt := make([]string, 0, len(allKeys))
-		for _, k := range allKeys[:limit] {
-			if k == "" {
-				break
-			}
-			rt = append(rt, k)
+		if !strings.HasPrefix(k, req.Prefix) {
+			continue
+		}
+		if startAfter != "" && k <= startAfter {
+			continue
+		}
+		if !kvInfo.matchesSelector(selector) {
+			continue
+		}
+		if pattern != nil && !pattern.MatchString(k) {
+			continue
+		}
+		switch {
+		case limit == 0:
+			allKeys = append(allKeys, k)
+		case len(allKeys) <= limit:
+			heap.Push(&allKeys, k)
+		case k < allKeys[0]:
+			allKeys[0] = k
+			heap.Fix(&allKeys, 0)
 		}
-		return &pb.ListKeysResponse{Keys: rt}, nil
 	}
-
 	s.mu.RUnlock()
+	sort.Strings(allKeys)
 
-	keys := make([]string, 0, limit)
-	pattern, err := regexp.Compile(req.Pattern)
-	if err != nil {
-		return nil, ErrInvalidKeyPattern
+	resp := &pb.ListKeysResponse{Keys: allKeys}
+	if limit > 0 && len(allKeys) > limit {
+		resp.Keys = allKeys[:limit]
+		resp.NextPageToken = encodePageToken(resp.Keys[limit-1])
 	}
+	return resp, nil
+}
 
-	for _, key := range allKeys {
-		if pattern.MatchString(key) {
-			keys = append(keys, key)
-		}
-		if len(keys) >= limit {
-			break
+// keyHeap is a max-heap of keys, used by [Server.ListKeys] to keep the
+// first keys in lexicographic order
+type keyHeap []string
+
+func (h keyHeap) Len() int           { return len(h) }
+func (h keyHeap) Less(i, j int) bool { return h[i] > h[j] }
+func (h keyHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *keyHeap) Push(x any) {
+	*h = append(*h, x.(string))
+}
+
+func (h *keyHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// encodePageToken returns an opaque page token for [Server.ListKeys],
+// which continues after the given key
+func encodePageToken(key string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(key))
+}
+
+// decodePageToken returns the key encoded in the given page token
+func decodePageToken(token string) (string, error) {
+	key, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", KQError{
+			Message: "invalid page token",
+			Code:    codes.InvalidArgument,
 		}
 	}
-	return &pb.ListKeysResponse{Keys: keys}, nil
+	return string(key), nil
 }
 
 // Stats returns server statistics
@@ -1978,6 +2646,7 @@ func (s *Server) ClearHistory(
 	}
 
 	data := &pb.ClearHistoryResponse{}
+	namespace := s.Namespace(ctx)
 
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -1989,6 +2658,9 @@ func (s *Server) ClearHistory(
 		if e := ctx.Err(); e != nil {
 			return data, e
 		}
+		if keyNamespace, _ := splitNamespacedKey(key); keyNamespace != namespace {
+			continue
+		}
 		_, exists := s.store[key]
 		data.Keys++
 		if !exists {
@@ -2029,9 +2701,16 @@ func (s *Server) Clear(
 		return nil, ErrReadOnlyServer
 	}
 	logger := s.requestLogger(ctx)
+	namespace := s.Namespace(ctx)
 	logger.Info(
 		"clearing all keys",
+		slog.String("namespace", namespace),
+		slog.String("selector", req.Selector),
 	)
+	selector, err := parseLabelSelector(req.Selector)
+	if err != nil {
+		return nil, err
+	}
 	clientID := s.ClientID(ctx)
 
 	s.mu.Lock()
@@ -2046,40 +2725,47 @@ func (s *Server) Clear(
 	s.hmu.Lock()
 	defer s.hmu.Unlock()
 
-	keyCount := uint64(len(s.store))
-
-	var newTotalSize uint64
+	var keyCount uint64
 
 	clearResponse := &pb.ClearResponse{}
 
 	for key, kvInfo := range s.store {
 		if e := ctx.Err(); e != nil {
-			clearResponse.KeysDeleted = keyCount - uint64(len(s.store))
 			return clearResponse, e
 		}
+		// Only keys in the request's namespace (and matching the
+		// selector, if provided) are cleared
+		if keyNamespace, _ := splitNamespacedKey(key); keyNamespace != namespace {
+			continue
+		}
+		if !kvInfo.matchesSelector(selector) {
+			continue
+		}
+		keyCount++
+
 		// The only time we keep a key when clearing the store
 		// is when it has a reserved prefix, or when the
 		// key is locked, and we aren't forcing it to clear
-		if strings.HasPrefix(strings.ToLower(key), ReservedKeyPrefix) {
+		if strings.HasPrefix(strings.ToLower(keyName(key)), ReservedKeyPrefix) {
 			continue
 		}
 		_, isLocked := s.locks[key]
+		isLocked = isLocked || len(s.sharedLocks[key]) > 0 ||
+			s.semaphores[key] != nil
 		kvInfo.mu.Lock()
 		if req.Force || !isLocked {
-			if isLocked && s.locks != nil {
+			if keyLock, ok := s.locks[key]; ok {
+				s.unbindLease(keyLock.LeaseID, key, true)
 				delete(s.locks, key)
 			}
-			s.deleteKey(key, clientID, false)
+			s.deleteKey(key, clientID, Deleted)
 			delete(s.history, key)
 			clearResponse.KeysDeleted++
 		}
 		kvInfo.mu.Unlock()
 	}
-	s.totalSize.Store(newTotalSize)
-	s.numKeys.Store(uint64(len(s.store)))
 
 	clearResponse.Success = true
-	clearResponse.KeysDeleted = keyCount - uint64(len(s.store))
 	logger.Info(
 		fmt.Sprintf("cleared %d/%d keys", clearResponse.KeysDeleted, keyCount),
 	)
@@ -2107,34 +2793,25 @@ func (s *Server) GetRevision(
 	cfg := *s.cfg
 	s.cfgMu.RUnlock()
 
+	cfg = cfg.forNamespace(s.Namespace(ctx))
 	if cfg.RevisionLimit == 0 {
 		return nil, ErrVersioningDisabled
 	}
-
-	if in.Version == 0 {
-		return nil, KQError{
-			Message: "revision must be greater or less than zero",
-			Code:    codes.InvalidArgument,
-		}
-	}
+	in.Key = s.namespacedKey(ctx, in.Key)
 
 	s.hmu.RLock()
 	defer s.hmu.RUnlock()
 
-	var h *keyValueSnapshot
-	var found bool
-
-	if in.Version > 0 {
-		h, found = s.getVersion(in.Key, in.Version)
-	} else if in.Version < 0 {
-		h, found = s.getRelativeVersion(in.Key, in.Version)
+	h, err := s.findRevision(in.Key, in.Version)
+	if err != nil {
+		return nil, err
 	}
-
-	if !found {
-		return nil, ErrRevisionNotFound
+	value, err := h.rawValue(s.keys)
+	if err != nil {
+		return nil, err
 	}
 	return &pb.RevisionResponse{
-		Value:     h.Value,
+		Value:     value,
 		Timestamp: timestamppb.New(h.Timestamp),
 	}, nil
 }
@@ -2182,7 +2859,7 @@ func (s *Server) getRelativeVersion(k string, v int64) (
 
 func (s *Server) Register(
 	ctx context.Context,
-	_ *pb.RegisterRequest,
+	in *pb.RegisterRequest,
 ) (*pb.RegisterResponse, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -2201,6 +2878,21 @@ func (s *Server) Register(
 		return nil, status.Errorf(codes.InvalidArgument, "empty client_id")
 	}
 
+	var leaseTTL time.Duration
+	if in.LeaseTtl != nil {
+		s.cfgMu.RLock()
+		cfg := *s.cfg
+		s.cfgMu.RUnlock()
+		var err error
+		leaseTTL, err = validateLeaseTTL(
+			cfg.forNamespace(s.Namespace(ctx)),
+			in.LeaseTtl,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	p, ok := peer.FromContext(ctx)
 	s.cmu.Lock()
 	defer s.cmu.Unlock()
@@ -2210,7 +2902,12 @@ func (s *Server) Register(
 	}
 
 	ci, ok := s.clientInfo[clientID]
-	if ok {
+	switch {
+	case ok && leaseTTL > 0:
+		// A client that's already registered (ex: after restarting)
+		// can request a new lease
+		return s.registerLease(clientID, leaseTTL), nil
+	case ok:
 		return nil, KQError{
 			Message: "already registered",
 			Code:    codes.AlreadyExists,
@@ -2230,9 +2927,30 @@ func (s *Server) Register(
 	defer ci.mu.RUnlock()
 	s.clientInfo[clientID] = ci
 	s.logger.Info("new client seen", "client", ci, "address", addr)
+	if leaseTTL > 0 {
+		return s.registerLease(clientID, leaseTTL), nil
+	}
 	return &pb.RegisterResponse{Success: true}, nil
 }
 
+// registerLease grants a new lease to the given client, returning the
+// response to Register
+func (s *Server) registerLease(
+	clientID string,
+	ttl time.Duration,
+) *pb.RegisterResponse {
+	s.leaseMu.Lock()
+	defer s.leaseMu.Unlock()
+	l := s.grantLease(clientID, ttl)
+	s.logger.Info("granted lease", "lease", l)
+	return &pb.RegisterResponse{
+		Success:  true,
+		ClientId: clientID,
+		LeaseId:  l.ID,
+		LeaseTtl: durationpb.New(ttl),
+	}
+}
+
 func (s *Server) SetReadOnly(
 	ctx context.Context,
 	in *pb.ReadOnlyRequest,
@@ -2292,6 +3010,7 @@ func (s *Server) GetKeyMetric(
 
 	logger := s.requestLogger(ctx)
 	logger.Info("request for key metric", "key", in.Key)
+	in.Key = s.namespacedKey(ctx, in.Key)
 	s.keyStatMu.RLock()
 	keyStat := s.keyStats[in.Key]
 	if keyStat == nil {
@@ -2340,6 +3059,7 @@ func (s *Server) getStats() *pb.ServerMetrics {
 
 	var numKeys = s.numKeys.Load()
 	var totalSize = s.totalSize.Load()
+	var storedSize = s.storedSize.Load()
 
 	var numNewKeysSet = s.numEventCreated.Load()
 	var numKeysUpdated = s.numEventUpdated.Load()
@@ -2350,6 +3070,7 @@ func (s *Server) getStats() *pb.ServerMetrics {
 	var numKeysExpunged = s.numEventExpunged.Load()
 	var numLifespanSet = s.numEventLifespanSet.Load()
 	var numLifespanRenewed = s.numEventLifespanRenewed.Load()
+	var numLifespanCleared = s.numEventLifespanCleared.Load()
 	var clientsSeen = s.clientIDs.Load()
 	var accesses = s.numEventValueAccessed.Load()
 
@@ -2359,6 +3080,7 @@ func (s *Server) getStats() *pb.ServerMetrics {
 	var pruneCompleted = s.numPruneCompleted.Load()
 	var currentReapers = s.numReapers.Load()
 	var currentLocks = s.numLocks.Load()
+	var lockWaiters = s.numLockWaiters.Load()
 
 	p := s.pressure()
 	var used float32
@@ -2377,21 +3099,47 @@ func (s *Server) getStats() *pb.ServerMetrics {
 		Accessed:        &accesses,
 		LifespanSet:     &numLifespanSet,
 		LifespanRenewed: &numLifespanRenewed,
+		LifespanCleared: &numLifespanCleared,
 	}
 	hkCount := uint64(len(s.history))
 	var revisionCount uint64
+	var historySize uint64
+	var historyStoredSize uint64
 
 	for _, kh := range s.history {
 		revisionCount = revisionCount + uint64(len(kh))
+		for _, rev := range kh {
+			historySize += rev.Size
+			historyStoredSize += uint64(len(rev.Value))
+		}
+	}
+
+	h := &pb.HistoryMetrics{
+		Keys:       &hkCount,
+		Revisions:  &revisionCount,
+		Size:       &historySize,
+		StoredSize: &historyStoredSize,
 	}
 
-	h := &pb.HistoryMetrics{Keys: &hkCount, Revisions: &revisionCount}
+	s.nsMu.RLock()
+	namespaces := make(map[string]*pb.NamespaceMetrics, len(s.namespaces))
+	for namespace, nm := range s.namespaces {
+		nsKeys := nm.numKeys.Load()
+		nsSize := nm.totalSize.Load()
+		namespaces[namespace] = &pb.NamespaceMetrics{
+			Keys:      &nsKeys,
+			TotalSize: &nsSize,
+		}
+	}
+	s.nsMu.RUnlock()
 
 	m := &pb.ServerMetrics{
 		Keys:                &numKeys,
 		TotalSize:           &totalSize,
+		StoredSize:          &storedSize,
 		Events:              eventMetrics,
 		CurrentLocks:        &currentLocks,
+		LockWaiters:         &lockWaiters,
 		ClientIds:           &clientsSeen,
 		Reapers:             &currentReapers,
 		SnapshotsCreated:    &snapshots,
@@ -2399,6 +3147,7 @@ func (s *Server) getStats() *pb.ServerMetrics {
 		EagerPruneTriggered: &eagerPruneTriggered,
 		PruneCompleted:      &pruneCompleted,
 		History:             h,
+		Namespaces:          namespaces,
 		Pressure: &pb.KeyPressure{
 			Keys: &p.Keys,
 			Max:  &p.Max,
@@ -2411,7 +3160,9 @@ func (s *Server) getStats() *pb.ServerMetrics {
 			"metric",
 			slog.Uint64("keys", *m.Keys),
 			slog.Uint64("total_size", *m.TotalSize),
+			slog.Uint64("stored_size", *m.StoredSize),
 			slog.Uint64("current_locks", *m.CurrentLocks),
+			slog.Uint64("lock_waiters", *m.LockWaiters),
 			slog.Uint64("reapers", *m.Reapers),
 			slog.Uint64("client_ids", *m.ClientIds),
 			slog.Uint64("event_subscribers", *m.EventSubscribers),
@@ -2458,6 +3209,10 @@ func (s *Server) getStats() *pb.ServerMetrics {
 					strings.ToLower(LifespanRenewed.String()),
 					*m.Events.LifespanRenewed,
 				),
+				slog.Uint64(
+					strings.ToLower(LifespanCleared.String()),
+					*m.Events.LifespanCleared,
+				),
 			),
 		),
 	)
@@ -2681,6 +3436,8 @@ func (s *Server) Start(ctx context.Context) error {
 					msg = "lifespan set"
 				case LifespanRenewed:
 					msg = "lifespan renewed"
+				case LifespanCleared:
+					msg = "lifespan cleared"
 				case NoEvent:
 					if ev.Key != "" {
 						panic(ev)
@@ -2791,6 +3548,9 @@ func (s *Server) WatchKeyValue(
 	logger := s.requestLogger(ctx)
 	logger.Info("got new event watcher")
 
+	keyName := in.Key
+	in.Key = s.namespacedKey(ctx, in.Key)
+
 	var currentKV *keyValue
 	var exists bool
 	var streamErr error
@@ -2814,9 +3574,13 @@ func (s *Server) WatchKeyValue(
 		if !keyIsLocked || (lockClientID == clientID) {
 			currentKV.mu.RLock()
 
+			value, valueErr := currentKV.rawValue(s.keys)
+			if valueErr != nil {
+				logger.Error("error decompressing value", "error", valueErr)
+			}
 			kv = &pb.WatchKeyValueResponse{
-				Key:           currentKV.Key,
-				Value:         currentKV.Value,
+				Key:           keyName,
+				Value:         value,
 				Hash:          currentKV.Hash,
 				ContentType:   currentKV.ContentType,
 				Version:       currentKV.Version,
@@ -2869,7 +3633,7 @@ func (s *Server) WatchKeyValue(
 		switch ev.Event {
 		case Deleted, Expired, Expunged:
 			kv = &pb.WatchKeyValueResponse{
-				Key:            ev.Key,
+				Key:            keyName,
 				KeyEvent:       pb.KeyEvent(ev.Event),
 				EventClientId:  ev.ClientID,
 				EventTimestamp: timestamppb.New(ev.Time),
@@ -2909,22 +3673,27 @@ func (s *Server) WatchKeyValue(
 
 			currentKV.mu.RLock()
 			s.mu.RUnlock()
+			value, valueErr := currentKV.rawValue(s.keys)
+			if valueErr != nil {
+				logger.Error("error decompressing value", "error", valueErr)
+			}
 			kv = &pb.WatchKeyValueResponse{
-				Key:            ev.Key,
-				Value:          currentKV.Value,
+				Key:            keyName,
+				Value:          value,
 				Hash:           currentKV.Hash,
 				ContentType:    currentKV.ContentType,
 				Version:        currentKV.Version,
 				KeyEvent:       pb.KeyEvent(ev.Event),
 				EventClientId:  ev.ClientID,
 				EventTimestamp: timestamppb.New(ev.Time),
+				ChangedFields:  ev.Fields,
 			}
 			currentKV.mu.RUnlock()
 			logger.Info(
 				"sending key value to subscribed client",
 				slog.Group(
 					"key_value",
-					slog.String("key", in.Key),
+					slog.String("key", keyName),
 					slog.Uint64("hash", kv.Hash),
 					slog.String("content_type", kv.ContentType),
 					slog.Uint64("version", kv.Version),
@@ -2967,6 +3736,21 @@ func (s *Server) WatchStream(
 	logger := s.requestLogger(ctx)
 	logger.Info("got new event watcher")
 
+	// Only events for keys in the request's namespace are sent
+	namespace := s.Namespace(ctx)
+	for i, k := range in.Keys {
+		in.Keys[i] = namespacedKey(namespace, k)
+	}
+
+	selector, err := parseLabelSelector(in.Selector)
+	if err != nil {
+		return err
+	}
+	var matched map[string]bool
+	if selector != nil {
+		matched = s.keysMatchingSelector(namespace, selector)
+	}
+
 	sctx, cancel := context.WithCancel(ctx)
 	streamClientID := fmt.Sprintf("%s/WatchStream", clientID)
 	events, err := s.Subscribe(sctx, streamClientID, in.Keys, nil)
@@ -2988,22 +3772,29 @@ func (s *Server) WatchStream(
 	}
 
 	for ev := range events {
+		keyNamespace, keyName := splitNamespacedKey(ev.Key)
 		switch {
+		case keyNamespace != namespace:
+			continue
 		case len(targetEvents) > 0 && !sliceContains(targetEvents, ev.Event):
 			continue
 		case len(in.Keys) > 0 && !sliceContains(in.Keys, ev.Key):
 			continue
 		case len(in.ClientIds) > 0 && !sliceContains(in.ClientIds, ev.ClientID):
 			continue
+		case selector != nil && !s.keyMatchesSelector(ev.Key, selector, matched):
+			continue
 		}
 
 		logger.Debug("saw client stream event", "event", ev)
 		if e := stream.Send(
 			&pb.Event{
-				Key:      ev.Key,
-				Event:    pb.KeyEvent(ev.Event),
-				Time:     timestamppb.New(ev.Time),
-				ClientId: ev.ClientID,
+				Key:           keyName,
+				Event:         pb.KeyEvent(ev.Event),
+				Time:          timestamppb.New(ev.Time),
+				ClientId:      ev.ClientID,
+				TxnId:         ev.TxnID,
+				ChangedFields: ev.Fields,
 			},
 		); e != nil {
 			logger.Warn(
@@ -3101,7 +3892,10 @@ func (s *Server) ClientInfo(ctx context.Context) *ClientInfo {
 func (s *Server) MarshalJSON() (data []byte, err error) {
 	keys := make([]*keyValue, 0, len(s.store))
 	for _, kvInfo := range s.store {
-		if strings.HasPrefix(strings.ToLower(kvInfo.Key), ReservedKeyPrefix) {
+		if strings.HasPrefix(
+			strings.ToLower(keyName(kvInfo.Key)),
+			ReservedKeyPrefix,
+		) {
 			continue
 		}
 		kvInfo.mu.RLock()
@@ -3123,6 +3917,11 @@ func (s *Server) MarshalJSON() (data []byte, err error) {
 	for _, keyLock := range s.locks {
 		locks = append(locks, keyLock)
 	}
+	for _, holders := range s.sharedLocks {
+		for _, keyLock := range holders {
+			locks = append(locks, keyLock)
+		}
+	}
 
 	reapers := make([]*reaper, 0, len(s.reapers))
 	for _, keyReaper := range s.reapers {
@@ -3140,12 +3939,28 @@ func (s *Server) MarshalJSON() (data []byte, err error) {
 		}
 	}()
 
+	semaphores := make([]*semaphore, 0, len(s.semaphores))
+	for _, sem := range s.semaphores {
+		semaphores = append(semaphores, sem)
+	}
+
+	s.leaseMu.RLock()
+	defer s.leaseMu.RUnlock()
+	leases := make([]*lease, 0, len(s.leases))
+	for _, l := range s.leases {
+		leases = append(leases, l)
+	}
+
 	state := kvStoreState{
-		Keys:    keys,
-		Clients: clients,
-		Locks:   locks,
-		Reapers: reapers,
-		Version: build.Version,
+		Keys:          keys,
+		Clients:       clients,
+		Locks:         locks,
+		Reapers:       reapers,
+		Version:       build.Version,
+		FencingTokens: s.fencingTokens,
+		FencingToken:  s.fencingToken,
+		Semaphores:    semaphores,
+		Leases:        leases,
 	}
 	if s.cfg.PersistentRevisions {
 		state.History = s.history
@@ -3170,6 +3985,29 @@ func (s *Server) UnmarshalJSON(data []byte) error {
 			"snapshot_version", state.Version,
 		)
 	}
+
+	// Rather than dropping values that can't be decrypted, fail before
+	// anything's loaded so the missing key can be configured
+	for _, kvInfo := range state.Keys {
+		if err = kvInfo.canDecrypt(s.keys); err != nil {
+			return fmt.Errorf("key '%s': %w", kvInfo.Key, err)
+		}
+	}
+	for key, kh := range state.History {
+		for _, h := range kh {
+			if !h.Encrypted {
+				continue
+			}
+			if err = s.keys.canDecryptValue(h.Value); err != nil {
+				return fmt.Errorf(
+					"key '%s' version %d: %w",
+					key,
+					h.Version,
+					err,
+				)
+			}
+		}
+	}
 	if s.store == nil {
 		s.store = make(map[string]*keyValue)
 	}
@@ -3178,6 +4016,10 @@ func (s *Server) UnmarshalJSON(data []byte) error {
 		s.keyStats = make(map[string]*keyLifetimeMetric)
 	}
 
+	if s.namespaces == nil {
+		s.namespaces = make(map[string]*namespaceMetrics)
+	}
+
 	// channel to send keyValue for value hashing
 	hashChannel := make(chan *keyValue)
 	// receive keyValue when hashing is complete
@@ -3187,6 +4029,7 @@ func (s *Server) UnmarshalJSON(data []byte) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	workers := runtime.GOMAXPROCS(0)
+	compressionThreshold := s.cfg.CompressionThreshold
 
 	now := time.Now()
 
@@ -3200,7 +4043,7 @@ func (s *Server) UnmarshalJSON(data []byte) error {
 
 	if state.Reapers != nil {
 		for _, keyReaper := range state.Reapers {
-			reapTime := keyReaper.LifespanSet.Add(keyReaper.Lifespan)
+			reapTime := keyReaper.expiresAt()
 			if reapTime.Before(now) {
 				s.logger.Warn(
 					"anticipated key expiration time has passed",
@@ -3215,10 +4058,11 @@ func (s *Server) UnmarshalJSON(data []byte) error {
 			keyReaper.srv = s
 			s.reapers[keyReaper.Key] = keyReaper
 			s.numReapers.Add(1)
-			keyReaper.t = time.AfterFunc(
-				keyReaper.Lifespan,
-				keyReaper.ExpireFunc(),
-			)
+			d := keyReaper.Lifespan
+			if keyReaper.ExpireAt != nil {
+				d = time.Until(*keyReaper.ExpireAt)
+			}
+			keyReaper.t = time.AfterFunc(d, keyReaper.ExpireFunc())
 		}
 	}
 
@@ -3232,10 +4076,41 @@ func (s *Server) UnmarshalJSON(data []byte) error {
 				}
 
 				kvInfo.mu.Lock()
-				kvInfo.Size = uint64(len(kvInfo.Value))
+				// Values are stored according to the current
+				// compression threshold and encryption settings,
+				// regardless of how they were stored when the snapshot
+				// was taken
+				switch {
+				case kvInfo.Type != pb.ValueType_STRING:
+					if de := kvInfo.decryptElements(s.keys); de != nil {
+						s.logger.Error(
+							"error decrypting elements",
+							"error",
+							de,
+							"key",
+							kvInfo.Key,
+						)
+					}
+				case kvInfo.Compressed || kvInfo.Encrypted:
+					value, de := kvInfo.rawValue(s.keys)
+					if de != nil {
+						s.logger.Error(
+							"error decoding value",
+							"error",
+							de,
+							"key",
+							kvInfo.Key,
+						)
+					}
+					kvInfo.Value = value
+					kvInfo.Compressed = false
+					kvInfo.Encrypted = false
+				}
+				kvInfo.Size = kvInfo.valueSize()
 
 				s.numKeys.Add(1)
 				s.totalSize.Add(kvInfo.Size)
+				s.addNamespaceKey(kvInfo.Key, kvInfo.Size)
 
 				if kvInfo.Value != nil {
 					hashFunc := fnv.New64a()
@@ -3250,7 +4125,10 @@ func (s *Server) UnmarshalJSON(data []byte) error {
 					}
 					kvInfo.Hash = hashFunc.Sum64()
 					kvInfo.ContentType = http.DetectContentType(kvInfo.Value)
+					kvInfo.setValue(kvInfo.Value, compressionThreshold, s.valueKeys)
 				}
+				kvInfo.encryptElements(s.valueKeys)
+				s.storedSize.Add(kvInfo.storedSize())
 
 				if kvInfo.Created.IsZero() {
 					kvInfo.Created = time.Now()
@@ -3336,7 +4214,13 @@ func (s *Server) UnmarshalJSON(data []byte) error {
 			keyLock.srv = s
 			_, stillExists := s.store[keyLock.Key]
 			if stillExists {
-				s.locks[keyLock.Key] = keyLock
+				switch {
+				case keyLock.Shared:
+					s.addSharedLock(keyLock)
+				default:
+					s.locks[keyLock.Key] = keyLock
+				}
+				keyLock.expires = now.Add(keyLock.Duration)
 				keyLock.t = time.AfterFunc(
 					keyLock.Duration,
 					keyLock.UnlockFunc(),
@@ -3353,6 +4237,38 @@ func (s *Server) UnmarshalJSON(data []byte) error {
 		s.keyStatMu.RUnlock()
 	}
 
+	// Snapshots from before tokens were removed with their keys may
+	// have tokens for deleted keys, which still count towards the last
+	// token issued
+	if s.fencingTokens == nil {
+		s.fencingTokens = make(map[string]uint64)
+	}
+	s.fencingToken = max(s.fencingToken, state.FencingToken)
+	for key, token := range state.FencingTokens {
+		s.fencingToken = max(s.fencingToken, token)
+		if _, stillExists := s.store[key]; stillExists {
+			s.fencingTokens[key] = token
+		}
+	}
+
+	if s.semaphores == nil {
+		s.semaphores = make(map[string]*semaphore)
+	}
+	for _, sem := range state.Semaphores {
+		if _, stillExists := s.store[sem.Key]; stillExists {
+			s.loadSemaphore(sem)
+		}
+	}
+
+	s.leaseMu.Lock()
+	if s.leases == nil {
+		s.leases = make(map[string]*lease)
+	}
+	for _, l := range state.Leases {
+		s.loadLease(l)
+	}
+	s.leaseMu.Unlock()
+
 	if state.History != nil {
 		if s.history == nil {
 			s.history = make(map[string][]*keyValueSnapshot)
@@ -3431,13 +4347,27 @@ func (s *Server) emit(
 		t := time.Now()
 		eventTime = &t
 	}
-	ev := Event{
-		Key:      key,
-		Event:    event,
-		Time:     *eventTime,
-		ClientID: clientID,
+	s.emitEvent(
+		Event{
+			Key:      key,
+			Event:    event,
+			Time:     *eventTime,
+			ClientID: clientID,
+		},
+	)
+}
+
+// emitEvent sends the given event, or adds it to the current transaction
+// if one is in progress
+func (s *Server) emitEvent(ev Event) {
+	if txn := s.txn.Load(); txn != nil && txn.add(ev) {
+		return
 	}
+	s.sendEvent(ev)
+}
 
+// sendEvent sends the given event to the event channel
+func (s *Server) sendEvent(ev Event) {
 	go func() {
 		defer func() {
 			if r := recover(); r != nil {
@@ -3448,11 +4378,38 @@ func (s *Server) emit(
 			}
 		}()
 		s.logger.Debug("broadcasting event", "event", ev)
+		s.emitMu.RLock()
+		defer s.emitMu.RUnlock()
 		s.events <- ev
 		s.logger.Debug("event sent", "event", ev)
 	}()
 }
 
+// emitGroup sends the given events in order, without any other
+// events being sent between them
+func (s *Server) emitGroup(events []Event) {
+	if len(events) == 0 {
+		return
+	}
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				s.logger.Error(
+					"recovered from panic while emitting event group",
+					"panic", r,
+				)
+			}
+		}()
+		s.emitMu.Lock()
+		defer s.emitMu.Unlock()
+		for _, ev := range events {
+			s.logger.Debug("broadcasting event", "event", ev)
+			s.events <- ev
+		}
+		s.logger.Debug("event group sent", "count", len(events))
+	}()
+}
+
 func (s *Server) sanityCheck(ctx context.Context) error {
 	s.mu.RLock()
 	reportedKeyCount := s.numKeys.Load()
@@ -3462,6 +4419,9 @@ func (s *Server) sanityCheck(ctx context.Context) error {
 	s.lockMu.RLock()
 	reportedLockCount := s.numLocks.Load()
 	lockCount := uint64(len(s.locks))
+	for _, holders := range s.sharedLocks {
+		lockCount += uint64(len(holders))
+	}
 	defer s.lockMu.RUnlock()
 
 	s.reaperMu.RLock()
@@ -3598,6 +4558,12 @@ func (s *Server) addKeyValueInfo(kvInfo *keyValue, size uint64) {
 	s.store[kvInfo.Key] = kvInfo
 	s.numKeys.Add(1)
 	s.totalSize.Add(size)
+	if kvInfo.Compressed || kvInfo.Encrypted {
+		s.storedSize.Add(uint64(len(kvInfo.Value)))
+	} else {
+		s.storedSize.Add(size)
+	}
+	s.addNamespaceKey(kvInfo.Key, size)
 
 	s.keyStatMu.Lock()
 	_, exists := s.keyStats[kvInfo.Key]
@@ -3774,6 +4740,45 @@ func (s *Server) stopUnlockTimers() {
 			keyLock.t = nil
 		}
 	}
+	for key, holders := range s.sharedLocks {
+		for _, keyLock := range holders {
+			if keyLock.t != nil {
+				stopped := keyLock.t.Stop()
+				s.logger.Debug(
+					"stopped shared unlock timer",
+					"key",
+					key,
+					"stopped",
+					stopped,
+				)
+				keyLock.t = nil
+			}
+		}
+	}
+	for key, sem := range s.semaphores {
+		for _, permit := range sem.Permits {
+			if permit.t != nil {
+				stopped := permit.t.Stop()
+				s.logger.Debug(
+					"stopped semaphore permit timer",
+					"key",
+					key,
+					"stopped",
+					stopped,
+				)
+				permit.t = nil
+			}
+		}
+	}
+
+	s.leaseMu.Lock()
+	defer s.leaseMu.Unlock()
+	for _, l := range s.leases {
+		if l.t != nil {
+			_ = l.t.Stop()
+			l.t = nil
+		}
+	}
 }
 
 // stopExpirationTimers runs with Stop to prevent any existing expiration
@@ -3800,6 +4805,7 @@ func (s *Server) stopExpirationTimers() {
 
 func (s *Server) staleKeys(
 	ctx context.Context,
+	namespace *string,
 	ignoreKey ...string,
 ) []*keyValue {
 	targetKeys := make([]*keyMetricWithName, 0, len(s.store))
@@ -3812,9 +4818,14 @@ func (s *Server) staleKeys(
 		if ctx.Err() != nil {
 			break
 		}
-		if strings.HasPrefix(k, ReservedKeyPrefix) {
+		if strings.HasPrefix(keyName(k), ReservedKeyPrefix) {
 			continue
 		}
+		if namespace != nil {
+			if keyNamespace, _ := splitNamespacedKey(k); keyNamespace != *namespace {
+				continue
+			}
+		}
 		if sliceContains(ignoreKey, k) {
 			continue
 		}
@@ -3841,7 +4852,10 @@ func (s *Server) staleKeys(
 
 }
 
-func (s *Server) deleteKey(key string, clientID string, expunged bool) {
+// deleteKey removes the given key, along with its lifespan and any locks,
+// and emits the given event (Deleted, Expired or Expunged) on behalf of
+// the given client
+func (s *Server) deleteKey(key string, clientID string, event KeyEvent) {
 	kvInfo, ok := s.store[key]
 	if !ok {
 		return
@@ -3863,9 +4877,15 @@ func (s *Server) deleteKey(key string, clientID string, expunged bool) {
 			s.logger.Debug("stopping lock timer", "key", key)
 			_ = keyLock.t.Stop()
 		}
+		s.unbindLease(keyLock.LeaseID, key, true)
 		delete(s.locks, key)
 		s.numLocks.Add(decrementUint64)
 	}
+	s.removeSharedLocks(key)
+	s.unbindLease(kvInfo.LeaseID, key, false)
+	s.removeSemaphore(key)
+	s.notifyLockWaiter(key)
+	delete(s.fencingTokens, key)
 
 	delete(s.store, key)
 	s.logger.Info(
@@ -3874,16 +4894,12 @@ func (s *Server) deleteKey(key string, clientID string, expunged bool) {
 	)
 
 	t := time.Now()
-	switch {
-	case expunged:
-		s.emit(key, Expunged, clientID, &t)
-	default:
-		s.emit(key, Deleted, clientID, &t)
-	}
+	s.emit(key, event, clientID, &t)
 
 	s.totalSize.Add(^(kvInfo.Size - 1))
+	s.storedSize.Add(^(kvInfo.storedSize() - 1))
 	s.numKeys.Add(decrementUint64)
-
+	s.removeNamespaceKey(key, kvInfo.Size)
 }
 
 func (s *Server) expungeKey(kvInfo *keyValue) (string, bool) {
@@ -3894,7 +4910,7 @@ func (s *Server) expungeKey(kvInfo *keyValue) (string, bool) {
 		return kvInfo.Key, false
 	}
 
-	s.deleteKey(kvInfo.Key, InternalClientID, true)
+	s.deleteKey(kvInfo.Key, InternalClientID, Expunged)
 	delete(s.history, kvInfo.Key)
 
 	return kvInfo.Key, true
@@ -3913,6 +4929,76 @@ func (s *Server) getKey(key string) *keyValue {
 	return keyInfo
 }
 
+// validateWriteMode returns an error if the given [pb.WriteMode] is unknown,
+// or if it requires an expected version and/or hash and neither was provided
+func validateWriteMode(
+	mode pb.WriteMode,
+	version *uint64,
+	hash *uint64,
+) error {
+	switch mode {
+	case pb.WriteMode_UNCONDITIONAL,
+		pb.WriteMode_CREATE_ONLY,
+		pb.WriteMode_UPDATE_ONLY:
+		return nil
+	case pb.WriteMode_IF_VERSION_MATCHES:
+		if version == nil && hash == nil {
+			return ErrMissingExpectedVersion
+		}
+		return nil
+	default:
+		return ErrInvalidWriteMode
+	}
+}
+
+// checkWriteCondition returns a [WriteConflictError] if the condition
+// for the given [pb.WriteMode] isn't met by kvInfo, which should be
+// nil if the key doesn't currently exist.
+func checkWriteCondition(
+	key string,
+	kvInfo *keyValue,
+	mode pb.WriteMode,
+	version *uint64,
+	hash *uint64,
+) error {
+	conflict := &WriteConflictError{Key: keyName(key)}
+	if kvInfo != nil {
+		kvInfo.mu.RLock()
+		conflict.Exists = true
+		conflict.Version = kvInfo.Version
+		conflict.Hash = kvInfo.Hash
+		kvInfo.mu.RUnlock()
+	}
+
+	//goland:noinspection GoSwitchMissingCasesForIotaConsts
+	switch mode {
+	case pb.WriteMode_CREATE_ONLY:
+		if !conflict.Exists {
+			return nil
+		}
+		conflict.Message = "key already exists"
+	case pb.WriteMode_UPDATE_ONLY:
+		if conflict.Exists {
+			return nil
+		}
+		conflict.Message = "key does not exist"
+	case pb.WriteMode_IF_VERSION_MATCHES:
+		switch {
+		case !conflict.Exists:
+			conflict.Message = "key does not exist"
+		case version != nil && *version != conflict.Version:
+			conflict.Message = "version mismatch"
+		case hash != nil && *hash != conflict.Hash:
+			conflict.Message = "hash mismatch"
+		default:
+			return nil
+		}
+	default:
+		return nil
+	}
+	return conflict
+}
+
 func (s *Server) evLoopHandleCreated(event Event) {
 	s.keyStatMu.RLock()
 	defer s.keyStatMu.RUnlock()
@@ -4037,6 +5123,13 @@ func (s *Server) eventLoop(
 
 	for event := range s.events {
 		evLock.Lock()
+		// Messages published to topics are only sent to subscribers, as
+		// they're never stored or logged
+		if event.Topic != "" {
+			s.broadcast(ctx, event, nil, nil, eventStreamCh)
+			evLock.Unlock()
+			continue
+		}
 		switch event.Event {
 		case Created:
 			s.evLoopHandleCreated(event)
@@ -4058,6 +5151,8 @@ func (s *Server) eventLoop(
 			s.numEventLifespanSet.Add(1)
 		case LifespanRenewed:
 			s.numEventLifespanRenewed.Add(1)
+		case LifespanCleared:
+			s.numEventLifespanCleared.Add(1)
 		case NoEvent:
 			//
 		}
@@ -4234,6 +5329,21 @@ type Config struct {
 	// MaxKeyLength is the maximum length of a key name. Default: 1024
 	MaxKeyLength uint64 `json:"max_key_length" yaml:"max_key_length" mapstructure:"max_key_length"`
 
+	// CompressionThreshold, if greater than 0, compresses values (and
+	// their revisions) larger than this many bytes in memory, when that
+	// makes them smaller. Values are decompressed when read.
+	CompressionThreshold uint64 `json:"compression_threshold" yaml:"compression_threshold" mapstructure:"compression_threshold"`
+
+	// Namespaces sets per-namespace overrides for MaxNumberOfKeys,
+	// MaxValueSize and RevisionLimit, mapped by namespace name. The
+	// default namespace ("") can't be overridden. See NamespaceConfig.
+	Namespaces map[string]NamespaceConfig `json:"namespaces,omitempty" yaml:"namespaces,omitempty" mapstructure:"namespaces"`
+
+	// Schemas binds JSON Schemas to key prefixes. Values set on keys with
+	// a bound prefix are rejected if they don't validate against its
+	// schema. See SchemaConfig.
+	Schemas []SchemaConfig `json:"schemas,omitempty" yaml:"schemas,omitempty" mapstructure:"schemas"`
+
 	// RevisionLimit is the maximum number of revisions to keep for each key.
 	RevisionLimit int64 `json:"revision_limit" yaml:"revision_limit" mapstructure:"revision_limit"`
 
@@ -4272,6 +5382,10 @@ type Config struct {
 	// Snapshot configures the snapshotting process
 	Snapshot SnapshotConfig // `json:"snapshot" yaml:"snapshot" mapstructure:"snapshot"`
 
+	// Encryption configures encryption of snapshots, and optionally of
+	// values held in memory
+	Encryption EncryptionConfig `json:"encryption" yaml:"encryption" mapstructure:"encryption"`
+
 	// StartFresh will ignore any existing snapshots and start with a clean slate.
 	// If snapshots are enabled, they will still be created.
 	StartFresh bool `json:"start_fresh" yaml:"start_fresh" mapstructure:"start_fresh"`
@@ -4371,6 +5485,18 @@ func (c Config) Validate() error {
 		)
 	}
 
+	if err := validateNamespaceConfigs(c.Namespaces); err != nil {
+		errs = append(errs, err)
+	}
+
+	if _, err := compileSchemaConfigs(c.Schemas); err != nil {
+		errs = append(errs, err)
+	}
+
+	if _, err := newKeyring(c.Encryption); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -4390,6 +5516,7 @@ func (c Config) LogValue() slog.Value {
 		slog.Uint64("max_keys", c.MaxNumberOfKeys),
 		slog.Uint64("max_value_size", c.MaxValueSize),
 		slog.Uint64("max_key_size", c.MaxKeyLength),
+		slog.Uint64("compression_threshold", c.CompressionThreshold),
 		slog.Duration("max_lock_duration", c.MaxLockDuration),
 		slog.Duration("min_lock_duration", c.MinLockDuration),
 		slog.Duration("min_lifespan", c.MinLifespan),
@@ -4421,6 +5548,9 @@ func (c Config) LogValue() slog.Value {
 			"event_stream_subscriber_limit",
 			c.EventStreamSubscriberLimit,
 		),
+		slog.Int("namespaces", len(c.Namespaces)),
+		slog.Int("schemas", len(c.Schemas)),
+		slog.Any("encryption", c.Encryption),
 	)
 }
 
@@ -4434,6 +5564,14 @@ type kvStoreState struct {
 	Version string                         `json:"version"`
 	Metrics map[string]*keyLifetimeMetric  `json:"metrics"`
 	History map[string][]*keyValueSnapshot `json:"history"`
+	// FencingTokens is the latest fencing token issued for each key
+	FencingTokens map[string]uint64 `json:"fencing_tokens,omitempty"`
+	// FencingToken is the last fencing token issued, for any key
+	FencingToken uint64 `json:"fencing_token,omitempty"`
+	// Semaphores are the semaphores with permits currently held
+	Semaphores []*semaphore `json:"semaphores,omitempty"`
+	// Leases are the leases which haven't expired
+	Leases []*lease `json:"leases,omitempty"`
 }
 
 // reaper manages the lifespan of a key. When the lifespan has
@@ -4448,6 +5586,11 @@ type reaper struct {
 	// LifespanSet is a timestamp for the last time the lifespan was set
 	LifespanSet time.Time `json:"lifespan_set"`
 
+	// ExpireAt is set when the key was given an absolute expiration time,
+	// rather than a lifespan. Updates to the key which don't set a new
+	// lifespan or expiration time won't extend it.
+	ExpireAt *time.Time `json:"expire_at,omitempty"`
+
 	// ID is a UUID for the current reaper. When the reaper function
 	// triggers, this ID is checked against the current reaper ID to
 	// determine if the key should be deleted. (If the reaper func
@@ -4494,15 +5637,28 @@ func (r *reaper) LogValue() slog.Value {
 	)
 }
 
+// expiresAt returns the time the key is expected to expire
+func (r *reaper) expiresAt() time.Time {
+	if r.ExpireAt != nil {
+		return *r.ExpireAt
+	}
+	return r.LifespanSet.Add(r.Lifespan)
+}
+
 // renew stops the current timer, and starts a new one with the given
-// duration. If the duration is nil, the current lifespan is used.
+// duration. If the duration is nil, the current lifespan is used,
+// unless the reaper has an absolute expiration time, which is kept.
 func (r *reaper) renew(d *time.Duration, clientID string) time.Time {
+	if d == nil && r.ExpireAt != nil {
+		return *r.ExpireAt
+	}
 	if r.t != nil {
 		_ = r.t.Stop()
 	}
 
 	if d != nil {
 		r.Lifespan = *d
+		r.ExpireAt = nil
 	}
 	ts := time.Now()
 	r.LifespanSet = ts
@@ -4584,6 +5740,31 @@ func (r *reaper) ExpireFunc() func() {
 			)
 			r.srv.numLocks.Add(decrementUint64)
 		}
+		r.srv.notifyLockWaiter(r.Key)
+		if r.srv.removeSharedLocks(r.Key) > 0 {
+			logger.Debug("removing shared locks for expired key")
+			events = append(
+				events,
+				Event{
+					Key:      r.Key,
+					Event:    Unlocked,
+					ClientID: InternalClientID,
+					Time:     time.Now(),
+				},
+			)
+		}
+		if r.srv.removeSemaphore(r.Key) > 0 {
+			logger.Debug("removing semaphore permits for expired key")
+			events = append(
+				events,
+				Event{
+					Key:      r.Key,
+					Event:    Unlocked,
+					ClientID: InternalClientID,
+					Time:     time.Now(),
+				},
+			)
+		}
 
 		delete(r.srv.reapers, r.Key)
 		r.srv.numReapers.Add(decrementUint64)
@@ -4596,6 +5777,8 @@ func (r *reaper) ExpireFunc() func() {
 		delete(r.srv.store, r.Key)
 		r.srv.numKeys.Add(decrementUint64)
 		r.srv.totalSize.Add(^(kvInfo.Size - 1))
+		r.srv.storedSize.Add(^(kvInfo.storedSize() - 1))
+		r.srv.removeNamespaceKey(r.Key, kvInfo.Size)
 
 		events = append(
 			events,
@@ -4625,9 +5808,20 @@ type kvLock struct {
 	// Created is the time the lock was created
 	Created time.Time `json:"created"`
 	// UUID to identify the current lock + lockfunc
-	ID  string `json:"id"`
-	t   *time.Timer
-	srv *Server
+	ID string `json:"id"`
+	// Shared is true for a shared lock (see Server.sharedLocks)
+	Shared bool `json:"shared,omitempty"`
+	// Permit is true for a semaphore permit (see Server.semaphores)
+	Permit bool `json:"permit,omitempty"`
+	// FencingToken is the fencing token issued for the lock
+	FencingToken uint64 `json:"fencing_token,omitempty"`
+	// LeaseID is the lease the lock was acquired with, which releases
+	// the lock when it expires
+	LeaseID string `json:"lease_id,omitempty"`
+	// expires is when the lock will be released
+	expires time.Time
+	t       *time.Timer
+	srv     *Server
 }
 
 // newKeyLock creates a new lock for a key, and starts the timer
@@ -4645,7 +5839,9 @@ func newKeyLock(
 		srv:      srv,
 		Created:  now,
 		ID:       uuid.NewString(),
+		expires:  now.Add(d),
 	}
+	k.FencingToken = srv.issueFencingToken(key)
 	t := time.AfterFunc(d, k.UnlockFunc())
 	k.t = t
 	srv.locks[key] = k
@@ -4662,6 +5858,7 @@ func (k *kvLock) renew(d time.Duration, clientID string) time.Time {
 	}
 	ts := time.Now()
 	k.Duration = d
+	k.expires = ts.Add(d)
 	k.ID = uuid.NewString()
 	k.t = time.AfterFunc(d, k.UnlockFunc())
 	k.srv.emit(k.Key, Locked, clientID, &ts)
@@ -4683,6 +5880,14 @@ func (k *kvLock) UnlockFunc() func() {
 		if k.srv.stopped {
 			return
 		}
+		if k.Shared {
+			k.srv.unlockShared(k.Key, k.ClientID, lockID)
+			return
+		}
+		if k.Permit {
+			k.srv.expirePermit(k.Key, k.ClientID, lockID)
+			return
+		}
 		lock, ok := k.srv.locks[k.Key]
 
 		if !ok {
@@ -4696,7 +5901,9 @@ func (k *kvLock) UnlockFunc() func() {
 		logger.Info("unlocking key on trigger")
 
 		delete(k.srv.locks, k.Key)
+		k.srv.unbindLease(k.LeaseID, k.Key, true)
 		k.srv.numLocks.Add(decrementUint64)
+		k.srv.notifyLockWaiter(k.Key)
 		now := time.Now()
 		k.srv.emit(k.Key, Unlocked, InternalClientID, &now)
 	}
@@ -4708,6 +5915,8 @@ func (k *kvLock) LogValue() slog.Value {
 		slog.String(clientIDKey, k.ClientID),
 		slog.Duration("duration", k.Duration),
 		slog.String("id", k.ID),
+		slog.Bool("shared", k.Shared),
+		slog.Bool("permit", k.Permit),
 	)
 }
 
@@ -4725,6 +5934,46 @@ func (e KQError) GRPCStatus() *status.Status {
 	return status.New(e.Code, e.Message)
 }
 
+// WriteConflictError is returned when the condition for a conditional
+// write (see [pb.WriteMode]) isn't met. The gRPC status has code
+// FailedPrecondition, with a [pb.WriteConflict] detail describing the
+// current state of the key.
+type WriteConflictError struct {
+	Message string `json:"message" yaml:"message"`
+	Key     string `json:"key" yaml:"key"`
+	Exists  bool   `json:"exists" yaml:"exists"`
+	Version uint64 `json:"version" yaml:"version"`
+	Hash    uint64 `json:"hash" yaml:"hash"`
+}
+
+func (e *WriteConflictError) Error() string {
+	if !e.Exists {
+		return e.Message
+	}
+	return fmt.Sprintf(
+		"%s (current version: %d, hash: %d)",
+		e.Message,
+		e.Version,
+		e.Hash,
+	)
+}
+
+func (e *WriteConflictError) GRPCStatus() *status.Status {
+	st := status.New(codes.FailedPrecondition, e.Error())
+	detailed, err := st.WithDetails(
+		&pb.WriteConflict{
+			Key:     e.Key,
+			Exists:  e.Exists,
+			Version: e.Version,
+			Hash:    e.Hash,
+		},
+	)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
 // ClientInfo describes when a client ID was first seen
 type ClientInfo struct {
 	ClientID  string    `json:"client_id" yaml:"client_id"`
@@ -1,7 +1,9 @@
 package server
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,13 +11,16 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"io"
 	"log"
 	"log/slog"
+	"math"
 	"net"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -25,6 +30,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const bufSize = 1024 * 1024
@@ -181,6 +187,7 @@ func newClient(
 	srv *Server,
 	lis *bufconn.Listener,
 	clientID string,
+	dialOpts ...grpc.DialOption,
 ) *kclient.Client {
 	t.Helper()
 	if lis == nil {
@@ -212,26 +219,32 @@ func newClient(
 	)
 	logger := slog.New(handler).WithGroup(fmt.Sprintf("%s-client", t.Name()))
 
+	dialOpts = append(
+		[]grpc.DialOption{
+			grpc.WithContextDialer(
+				func(
+					context.Context,
+					string,
+				) (net.Conn, error) {
+					return lis.Dial()
+				},
+			),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithKeepaliveParams(
+				keepalive.ClientParameters{
+					Time:    kclient.DefaultDialKeepAliveTime,
+					Timeout: kclient.DefaultDialKeepAliveTimeout,
+				},
+			),
+		},
+		dialOpts...,
+	)
 	client := kclient.New(
 		"bufnet",
 		clientID,
 		logger,
 		nil,
-		grpc.WithContextDialer(
-			func(
-				context.Context,
-				string,
-			) (net.Conn, error) {
-				return lis.Dial()
-			},
-		),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithKeepaliveParams(
-			keepalive.ClientParameters{
-				Time:    kclient.DefaultDialKeepAliveTime,
-				Timeout: kclient.DefaultDialKeepAliveTimeout,
-			},
-		),
+		dialOpts...,
 	)
 	connCtx, connCancel := context.WithTimeout(tctx, dialTimeout)
 	err := client.Dial(
@@ -332,6 +345,73 @@ func TestListKeys(t *testing.T) {
 	assertEqual(t, len(keys.Keys), 0)
 }
 
+func TestListKeysPagination(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+
+	expected := []string{"a/1", "a/2", "a/3", "a/4", "a/5"}
+	for _, k := range []string{"b/1", "a/3", "a/1", "a/5", "a/2", "a/4"} {
+		_, err := client.Set(ctx, &pb.KeyValue{Key: k, Value: []byte(k)})
+		fatalOnErr(t, err)
+	}
+
+	keys, err := client.ListKeys(ctx, &pb.ListKeysRequest{Prefix: "a/"})
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, keys.Keys, expected)
+	assertEqual(t, keys.NextPageToken, "")
+
+	seen := make([]string, 0, len(expected))
+	req := &pb.ListKeysRequest{Prefix: "a/", Limit: 2}
+	for {
+		keys, err = client.ListKeys(ctx, req)
+		fatalOnErr(t, err)
+		seen = append(seen, keys.Keys...)
+		if keys.NextPageToken == "" {
+			break
+		}
+		assertEqual(t, len(keys.Keys), 2)
+		req.PageToken = keys.NextPageToken
+	}
+	assertSlicesEqual(t, seen, expected)
+
+	keys, err = client.ListKeys(
+		ctx,
+		&pb.ListKeysRequest{StartAfter: "a/3", Limit: 2},
+	)
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, keys.Keys, []string{"a/4", "a/5"})
+	if keys.NextPageToken == "" {
+		t.Fatalf("expected next page token")
+	}
+
+	// Patterns are applied before the limit
+	patternKeys, err := client.ListKeys(
+		ctx,
+		&pb.ListKeysRequest{Pattern: "/[135]$", Limit: 2},
+	)
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, patternKeys.Keys, []string{"a/1", "a/3"})
+	patternKeys, err = client.ListKeys(
+		ctx,
+		&pb.ListKeysRequest{
+			Pattern:   "/[135]$",
+			Limit:     2,
+			PageToken: patternKeys.NextPageToken,
+		},
+	)
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, patternKeys.Keys, []string{"a/5", "b/1"})
+	assertEqual(t, patternKeys.NextPageToken, "")
+
+	_, err = client.ListKeys(
+		ctx,
+		&pb.ListKeysRequest{StartAfter: "a/3", PageToken: keys.NextPageToken},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	_, err = client.ListKeys(ctx, &pb.ListKeysRequest{PageToken: "!!"})
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+}
 func TestUpdateLockedKeyToken(t *testing.T) {
 	srv, lis := newServer(t, nil, nil)
 	client := newClient(t, srv, lis, "")
@@ -2918,3 +2998,3354 @@ func TestStopUnlockTimers(t *testing.T) {
 		assertEqual(t, r.t, nil)
 	}
 }
+
+func TestSetWriteMode(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+
+	_, err := client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:   "foo",
+			Value: []byte("bar"),
+			Mode:  pb.WriteMode_UPDATE_ONLY,
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	rv, err := client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:   "foo",
+			Value: []byte("bar"),
+			Mode:  pb.WriteMode_CREATE_ONLY,
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.IsNew, true)
+	assertEqual(t, rv.Version, 1)
+
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:   "foo",
+			Value: []byte("baz"),
+			Mode:  pb.WriteMode_CREATE_ONLY,
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	rv, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:   "foo",
+			Value: []byte("baz"),
+			Mode:  pb.WriteMode_UPDATE_ONLY,
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Version, 2)
+
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:   "foo",
+			Value: []byte("qux"),
+			Mode:  pb.WriteMode_IF_VERSION_MATCHES,
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	staleVersion := uint64(1)
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:     "foo",
+			Value:   []byte("qux"),
+			Mode:    pb.WriteMode_IF_VERSION_MATCHES,
+			Version: &staleVersion,
+		},
+	)
+	e, _ := status.FromError(err)
+	assertErrorCode(t, e.Code(), codes.FailedPrecondition)
+	details := e.Details()
+	assertEqual(t, len(details), 1)
+	conflict, ok := details[0].(*pb.WriteConflict)
+	if !ok {
+		t.Fatalf("expected *pb.WriteConflict, got %T", details[0])
+	}
+	assertEqual(t, conflict.Exists, true)
+	assertEqual(t, conflict.Version, rv.Version)
+	assertEqual(t, conflict.Hash, rv.Hash)
+
+	rv, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:     "foo",
+			Value:   []byte("qux"),
+			Mode:    pb.WriteMode_IF_VERSION_MATCHES,
+			Version: &conflict.Version,
+			Hash:    &conflict.Hash,
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Version, 3)
+
+	kv, err := client.Get(ctx, &pb.Key{Key: "foo"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), "qux")
+}
+
+func TestDeleteIfVersionMatches(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+
+	rv, err := client.Set(ctx, &pb.KeyValue{Key: "foo", Value: []byte("bar")})
+	fatalOnErr(t, err)
+
+	_, err = client.Delete(
+		ctx,
+		&pb.DeleteRequest{Key: "foo", Mode: pb.WriteMode_CREATE_ONLY},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	wrongHash := rv.Hash + 1
+	_, err = client.Delete(
+		ctx,
+		&pb.DeleteRequest{
+			Key:  "foo",
+			Mode: pb.WriteMode_IF_VERSION_MATCHES,
+			Hash: &wrongHash,
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	dr, err := client.Delete(
+		ctx,
+		&pb.DeleteRequest{
+			Key:     "foo",
+			Mode:    pb.WriteMode_IF_VERSION_MATCHES,
+			Version: &rv.Version,
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, dr.Deleted, true)
+
+	_, err = client.Delete(
+		ctx,
+		&pb.DeleteRequest{
+			Key:     "foo",
+			Mode:    pb.WriteMode_IF_VERSION_MATCHES,
+			Version: &rv.Version,
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+}
+
+func TestTxn(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+	otherClient := newClient(t, srv, lis, "someotherclientid")
+
+	_, err := client.Set(ctx, &pb.KeyValue{Key: "foo", Value: []byte("bar")})
+	fatalOnErr(t, err)
+	_, err = otherClient.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:          "locked",
+			Value:        []byte("baz"),
+			LockDuration: durationpb.New(1 * time.Hour),
+		},
+	)
+	fatalOnErr(t, err)
+
+	// guard not met
+	_, err = client.Txn(
+		ctx,
+		&pb.TxnRequest{
+			Guards: []*pb.TxnGuard{
+				{Key: "foo", Check: pb.TxnCheck_VERSION_EQUALS, Version: 2},
+			},
+			Ops: []*pb.TxnOp{
+				{Op: &pb.TxnOp_Set{Set: &pb.KeyValue{Key: "a", Value: []byte("1")}}},
+			},
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	// one op can't be applied, so none should be
+	_, err = client.Txn(
+		ctx,
+		&pb.TxnRequest{
+			Ops: []*pb.TxnOp{
+				{Op: &pb.TxnOp_Set{Set: &pb.KeyValue{Key: "a", Value: []byte("1")}}},
+				{Op: &pb.TxnOp_Delete{Delete: &pb.DeleteRequest{Key: "locked"}}},
+			},
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+
+	exists, err := client.Exists(ctx, &pb.Key{Key: "a"})
+	fatalOnErr(t, err)
+	assertEqual(t, exists.Exists, false)
+
+	_, err = client.Txn(
+		ctx,
+		&pb.TxnRequest{
+			Ops: []*pb.TxnOp{
+				{Op: &pb.TxnOp_Set{Set: &pb.KeyValue{Key: "a", Value: []byte("1")}}},
+				{Op: &pb.TxnOp_Delete{Delete: &pb.DeleteRequest{Key: "a"}}},
+			},
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	rv, err := client.Txn(
+		ctx,
+		&pb.TxnRequest{
+			Guards: []*pb.TxnGuard{
+				{Key: "foo", Check: pb.TxnCheck_VERSION_EQUALS, Version: 1},
+				{Key: "a", Check: pb.TxnCheck_KEY_NOT_EXISTS},
+			},
+			Ops: []*pb.TxnOp{
+				{Op: &pb.TxnOp_Set{Set: &pb.KeyValue{Key: "a", Value: []byte("1")}}},
+				{Op: &pb.TxnOp_Set{Set: &pb.KeyValue{Key: "foo", Value: []byte("qux")}}},
+				{
+					Op: &pb.TxnOp_Lock{
+						Lock: &pb.LockRequest{
+							Key:             "b",
+							Duration:        durationpb.New(1 * time.Hour),
+							CreateIfMissing: true,
+						},
+					},
+				},
+			},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, len(rv.Results), 3)
+	assertEqual(t, rv.Results[0].GetSet().IsNew, true)
+	assertEqual(t, rv.Results[1].GetSet().Version, 2)
+	assertEqual(t, rv.Results[2].GetLock().Success, true)
+
+	kv, err := client.Get(ctx, &pb.Key{Key: "foo"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), "qux")
+
+	_, err = client.Txn(
+		ctx,
+		&pb.TxnRequest{
+			Guards: []*pb.TxnGuard{
+				{Key: "b", Check: pb.TxnCheck_LOCKED_BY_ME},
+			},
+			Ops: []*pb.TxnOp{
+				{Op: &pb.TxnOp_Delete{Delete: &pb.DeleteRequest{Key: "b"}}},
+			},
+		},
+	)
+	fatalOnErr(t, err)
+
+	_, err = otherClient.Txn(
+		ctx,
+		&pb.TxnRequest{
+			Guards: []*pb.TxnGuard{
+				{Key: "a", Check: pb.TxnCheck_LOCKED_BY_ME},
+			},
+			Ops: []*pb.TxnOp{
+				{Op: &pb.TxnOp_Delete{Delete: &pb.DeleteRequest{Key: "a"}}},
+			},
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+}
+
+func TestTxnEvents(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+
+	events, err := srv.Subscribe(ctx, "txn", []string{"a", "b"}, nil)
+	fatalOnErr(t, err)
+
+	rv, err := client.Txn(
+		ctx,
+		&pb.TxnRequest{
+			Ops: []*pb.TxnOp{
+				{Op: &pb.TxnOp_Set{Set: &pb.KeyValue{Key: "a", Value: []byte("1")}}},
+				{Op: &pb.TxnOp_Set{Set: &pb.KeyValue{Key: "b", Value: []byte("2")}}},
+			},
+		},
+	)
+	fatalOnErr(t, err)
+	if rv.TxnId == "" {
+		t.Fatalf("expected txn ID")
+	}
+
+	var seen []string
+	timeout := time.After(5 * time.Second)
+	for len(seen) < 2 {
+		select {
+		case ev := <-events:
+			assertEqual(t, ev.Event, Created)
+			assertEqual(t, ev.TxnID, rv.TxnId)
+			seen = append(seen, ev.Key)
+		case <-timeout:
+			t.Fatalf("timed out waiting for events, got: %v", seen)
+		}
+	}
+	assertEqual(t, seen[0], "a")
+	assertEqual(t, seen[1], "b")
+	fatalOnErr(t, srv.Unsubscribe("txn"))
+}
+
+func TestBatch(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+	otherClient := newClient(t, srv, lis, "someotherclientid")
+	startingKeyCount := srv.numKeys.Load()
+
+	_, err := otherClient.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:          "locked",
+			Value:        []byte("baz"),
+			LockDuration: durationpb.New(1 * time.Hour),
+		},
+	)
+	fatalOnErr(t, err)
+
+	setResp, err := client.BatchSet(
+		ctx,
+		&pb.BatchSetRequest{
+			Items: []*pb.KeyValue{
+				{Key: "foo", Value: []byte("bar")},
+				{Key: "locked", Value: []byte("qux")},
+				{Key: "", Value: []byte("empty")},
+				{Key: "bar", Value: []byte("baz")},
+			},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, len(setResp.Results), 4)
+	assertEqual(t, setResp.Results[0].Result.IsNew, true)
+	assertEqual(t, setResp.Results[1].Error.Code, uint32(codes.PermissionDenied))
+	assertEqual(t, setResp.Results[2].Error.Code, uint32(codes.InvalidArgument))
+	assertEqual(t, setResp.Results[3].Result.IsNew, true)
+
+	_, err = client.ListPush(
+		ctx,
+		&pb.ListPushRequest{Key: "list", Values: [][]byte{[]byte("a")}},
+	)
+	fatalOnErr(t, err)
+
+	getResp, err := client.BatchGet(
+		ctx,
+		&pb.BatchGetRequest{
+			Keys: []string{"foo", "locked", "missing", "bar", "list"},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, len(getResp.Results), 5)
+	assertEqual(t, string(getResp.Results[0].Value), "bar")
+	assertEqual(t, getResp.Results[1].Error.Code, uint32(codes.PermissionDenied))
+	assertEqual(t, getResp.Results[2].Error.Code, uint32(codes.NotFound))
+	assertEqual(t, string(getResp.Results[3].Value), "baz")
+	assertEqual(
+		t,
+		getResp.Results[4].Error.Code,
+		uint32(codes.FailedPrecondition),
+	)
+
+	delResp, err := client.BatchDelete(
+		ctx,
+		&pb.BatchDeleteRequest{
+			Items: []*pb.DeleteRequest{
+				{Key: "foo"},
+				{Key: "locked"},
+				{Key: "missing"},
+			},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, len(delResp.Results), 3)
+	assertEqual(t, delResp.Results[0].Result.Deleted, true)
+	assertEqual(t, delResp.Results[1].Error.Code, uint32(codes.PermissionDenied))
+	assertEqual(t, delResp.Results[2].Error.Code, uint32(codes.NotFound))
+
+	assertEqual(t, srv.numKeys.Load(), startingKeyCount+3)
+}
+
+func TestIncrement(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+	otherClient := newClient(t, srv, lis, "someotherclientid")
+
+	rv, err := client.Increment(ctx, &pb.IncrementRequest{Key: "counter"})
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Value, 1)
+	assertEqual(t, rv.IsNew, true)
+	assertEqual(t, rv.Version, 1)
+
+	amount := int64(10)
+	rv, err = client.Increment(
+		ctx,
+		&pb.IncrementRequest{Key: "counter", Amount: &amount},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Value, 11)
+	assertEqual(t, rv.IsNew, false)
+	assertEqual(t, rv.Version, 2)
+
+	amount = -20
+	rv, err = client.Increment(
+		ctx,
+		&pb.IncrementRequest{Key: "counter", Amount: &amount},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Value, -9)
+	assertEqual(t, rv.Version, 3)
+
+	gv, err := client.Get(ctx, &pb.Key{Key: "counter"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(gv.Value), "-9")
+
+	revision, err := client.GetRevision(
+		ctx,
+		&pb.GetRevisionRequest{Key: "counter", Version: 2},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, string(revision.Value), "11")
+
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "foo", Value: []byte("bar")})
+	fatalOnErr(t, err)
+	_, err = client.Increment(ctx, &pb.IncrementRequest{Key: "foo"})
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:   "counter",
+			Value: []byte(strconv.FormatInt(math.MaxInt64, 10)),
+		},
+	)
+	fatalOnErr(t, err)
+	_, err = client.Increment(ctx, &pb.IncrementRequest{Key: "counter"})
+	assertErrorCode(t, status.Code(err), codes.OutOfRange)
+
+	_, err = client.Lock(
+		ctx,
+		&pb.LockRequest{Key: "counter", Duration: durationpb.New(time.Hour)},
+	)
+	fatalOnErr(t, err)
+	_, err = otherClient.Increment(ctx, &pb.IncrementRequest{Key: "counter"})
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+}
+
+func TestScan(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+	otherClient := newClient(t, srv, lis, "someotherclientid")
+
+	for _, k := range []string{"a/3", "a/1", "b/1", "a/2", "a/4"} {
+		_, err := client.Set(ctx, &pb.KeyValue{Key: k, Value: []byte(k)})
+		fatalOnErr(t, err)
+	}
+	_, err := client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:      "a/2",
+			Value:    []byte("updated"),
+			Lifespan: durationpb.New(time.Hour),
+		},
+	)
+	fatalOnErr(t, err)
+	_, err = otherClient.Lock(
+		ctx,
+		&pb.LockRequest{Key: "a/3", Duration: durationpb.New(time.Hour)},
+	)
+	fatalOnErr(t, err)
+	_, err = otherClient.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:      "a/4",
+			Duration: durationpb.New(time.Hour),
+			Mode:     pb.LockMode_SHARED,
+		},
+	)
+	fatalOnErr(t, err)
+	_, err = otherClient.AcquireSemaphore(
+		ctx,
+		&pb.AcquireSemaphoreRequest{
+			Name:       "b/1",
+			PermitsMax: 2,
+			Ttl:        durationpb.New(time.Hour),
+		},
+	)
+	fatalOnErr(t, err)
+	_, err = client.ListPush(
+		ctx,
+		&pb.ListPushRequest{Key: "c/list", Values: [][]byte{[]byte("x")}},
+	)
+	fatalOnErr(t, err)
+
+	scan := func(req *pb.ScanRequest) []*pb.ScanResult {
+		t.Helper()
+		stream, e := client.Scan(ctx, req)
+		fatalOnErr(t, e)
+		results := make([]*pb.ScanResult, 0)
+		for {
+			result, re := stream.Recv()
+			if errors.Is(re, io.EOF) {
+				return results
+			}
+			fatalOnErr(t, re)
+			results = append(results, result)
+		}
+	}
+
+	// a/3 is locked by another client, so it's skipped
+	results := scan(&pb.ScanRequest{Prefix: "a/"})
+	assertEqual(t, len(results), 3)
+	assertEqual(t, results[0].Key, "a/1")
+	assertEqual(t, string(results[0].Value), "a/1")
+	assertEqual(t, results[0].Version, 1)
+	assertEqual(t, results[1].Key, "a/2")
+	assertEqual(t, string(results[1].Value), "updated")
+	assertEqual(t, results[1].Version, 2)
+	assertEqual(t, results[1].Lifespan.AsDuration(), time.Hour)
+	assertEqual(t, results[1].Locked, false)
+
+	// Shared locks and semaphore permits held by another client are
+	// reported as locked, the same as Inspect, but don't hide the value
+	assertEqual(t, results[2].Key, "a/4")
+	assertEqual(t, results[2].Locked, true)
+	assertEqual(t, results[2].LockedByOther, false)
+	assertEqual(t, string(results[2].Value), "a/4")
+	inspected, err := client.Inspect(ctx, &pb.InspectRequest{Key: "a/4"})
+	fatalOnErr(t, err)
+	assertEqual(t, inspected.GetLocked(), true)
+
+	results = scan(&pb.ScanRequest{Prefix: "b/"})
+	assertEqual(t, len(results), 1)
+	assertEqual(t, results[0].Locked, true)
+	assertEqual(t, string(results[0].Value), "b/1")
+	inspected, err = client.Inspect(ctx, &pb.InspectRequest{Key: "b/1"})
+	fatalOnErr(t, err)
+	assertEqual(t, inspected.GetLocked(), true)
+
+	results = scan(&pb.ScanRequest{Prefix: "a/", IncludeLocked: true})
+	assertEqual(t, len(results), 4)
+	assertEqual(t, results[2].Key, "a/3")
+	assertEqual(t, results[2].Locked, true)
+	assertEqual(t, results[2].LockedByOther, true)
+	assertEqual(t, len(results[2].Value), 0)
+
+	results = scan(&pb.ScanRequest{Start: "a/2", End: "b/1"})
+	assertEqual(t, len(results), 2)
+	assertEqual(t, results[0].Key, "a/2")
+	assertEqual(t, results[1].Key, "a/4")
+
+	results = scan(&pb.ScanRequest{Start: "a/", Limit: 2})
+	assertEqual(t, len(results), 2)
+	assertEqual(t, results[1].Key, "a/2")
+	assertEqual(t, results[1].ValueType, pb.ValueType_STRING)
+
+	// Typed keys are included, with their type, but no value
+	results = scan(&pb.ScanRequest{Prefix: "c/"})
+	assertEqual(t, len(results), 1)
+	assertEqual(t, results[0].ValueType, pb.ValueType_LIST)
+	assertEqual(t, len(results[0].Value), 0)
+
+	stream, err := client.Scan(ctx, &pb.ScanRequest{Start: "b", End: "a"})
+	fatalOnErr(t, err)
+	_, err = stream.Recv()
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+}
+
+func TestNamespaces(t *testing.T) {
+	maxKeys := uint64(2)
+	maxValueSize := uint64(4)
+	cfg := NewConfig()
+	cfg.PruneInterval = 0
+	cfg.Namespaces = map[string]NamespaceConfig{
+		"limited": {MaxNumberOfKeys: &maxKeys, MaxValueSize: &maxValueSize},
+	}
+	srv, lis := newServer(t, nil, cfg)
+	client := newClient(t, srv, lis, "")
+	fooClient := newClient(t, srv, lis, "foo", kclient.WithNamespace("foo"))
+	limitedClient := newClient(
+		t,
+		srv,
+		lis,
+		"limited",
+		kclient.WithNamespace("limited"),
+	)
+
+	_, err := client.Set(ctx, &pb.KeyValue{Key: "k", Value: []byte("default")})
+	fatalOnErr(t, err)
+	_, err = fooClient.Set(ctx, &pb.KeyValue{Key: "k", Value: []byte("foo")})
+	fatalOnErr(t, err)
+
+	kv, err := client.Get(ctx, &pb.Key{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), "default")
+	kv, err = fooClient.Get(ctx, &pb.Key{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), "foo")
+
+	_, err = limitedClient.Get(ctx, &pb.Key{Key: "k"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	for i := 1; i <= 2; i++ {
+		incr, e := fooClient.Increment(ctx, &pb.IncrementRequest{Key: "n"})
+		fatalOnErr(t, e)
+		assertEqual(t, incr.Value, int64(i))
+	}
+	_, err = fooClient.Delete(ctx, &pb.DeleteRequest{Key: "n"})
+	fatalOnErr(t, err)
+
+	keys, err := fooClient.ListKeys(ctx, &pb.ListKeysRequest{})
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, keys.Keys, []string{"k"})
+
+	keys, err = client.ListKeys(ctx, &pb.ListKeysRequest{})
+	fatalOnErr(t, err)
+	for _, k := range keys.Keys {
+		if strings.HasPrefix(k, namespaceSeparator) {
+			t.Errorf("unexpected key from another namespace: %q", k)
+		}
+	}
+
+	stats, err := client.Stats(ctx, &pb.EmptyRequest{})
+	fatalOnErr(t, err)
+	fooStats, ok := stats.Namespaces["foo"]
+	if !ok {
+		t.Fatalf("expected metrics for namespace 'foo'")
+	}
+	assertEqual(t, fooStats.GetKeys(), 1)
+	assertEqual(t, fooStats.GetTotalSize(), 3)
+
+	// the namespace's MaxValueSize and MaxNumberOfKeys override the
+	// server's config
+	_, err = limitedClient.Set(
+		ctx,
+		&pb.KeyValue{Key: "a", Value: []byte("12345")},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	for _, k := range []string{"a", "b"} {
+		_, err = limitedClient.Set(ctx, &pb.KeyValue{Key: k, Value: []byte(k)})
+		fatalOnErr(t, err)
+	}
+	_, err = limitedClient.Set(ctx, &pb.KeyValue{Key: "c", Value: []byte("c")})
+	assertErrorCode(t, status.Code(err), codes.ResourceExhausted)
+	_, err = fooClient.Set(ctx, &pb.KeyValue{Key: "c", Value: []byte("c")})
+	fatalOnErr(t, err)
+
+	// events for keys in other namespaces aren't sent
+	watchCtx, watchCancel := context.WithCancel(ctx)
+	defer watchCancel()
+	stream, err := fooClient.WatchStream(watchCtx, &pb.WatchRequest{})
+	fatalOnErr(t, err)
+	for srv.numEventSubscribers.Load() < 1 {
+		time.Sleep(50 * time.Millisecond)
+	}
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "k", Value: []byte("updated")})
+	fatalOnErr(t, err)
+	_, err = fooClient.Set(ctx, &pb.KeyValue{Key: "k", Value: []byte("updated")})
+	fatalOnErr(t, err)
+	ev, err := stream.Recv()
+	fatalOnErr(t, err)
+	assertEqual(t, ev.Key, "k")
+	assertEqual(t, ev.ClientId, "foo")
+
+	cleared, err := fooClient.Clear(ctx, &pb.ClearRequest{Force: true})
+	fatalOnErr(t, err)
+	assertEqual(t, cleared.KeysDeleted, 2)
+	kv, err = client.Get(ctx, &pb.Key{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), "updated")
+	_, err = limitedClient.Get(ctx, &pb.Key{Key: "a"})
+	fatalOnErr(t, err)
+
+	badCtx := metadata.AppendToOutgoingContext(ctx, "namespace", "-bad")
+	_, err = client.Get(badCtx, &pb.Key{Key: "k"})
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	// The default namespace can't be overridden
+	defaultCfg := NewConfig()
+	defaultCfg.Namespaces = map[string]NamespaceConfig{
+		"": {MaxNumberOfKeys: &maxKeys},
+	}
+	if defaultCfg.Validate() == nil {
+		t.Fatalf("expected default namespace override to fail validation")
+	}
+	if _, err = New(defaultCfg); err == nil {
+		t.Fatalf("expected error overriding the default namespace")
+	}
+}
+
+func TestRename(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+	otherClient := newClient(t, srv, lis, "someotherclientid")
+
+	for _, v := range []string{"v1", "v2"} {
+		_, err := client.Set(
+			ctx,
+			&pb.KeyValue{
+				Key:      "src",
+				Value:    []byte(v),
+				Lifespan: durationpb.New(time.Hour),
+			},
+		)
+		fatalOnErr(t, err)
+	}
+
+	rv, err := client.Rename(
+		ctx,
+		&pb.RenameRequest{
+			Source:         "src",
+			Destination:    "dst",
+			IncludeHistory: true,
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Success, true)
+	assertEqual(t, rv.Version, 2)
+
+	_, err = client.Get(ctx, &pb.Key{Key: "src"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+	kv, err := client.Get(ctx, &pb.Key{Key: "dst"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), "v2")
+
+	info, err := client.Inspect(ctx, &pb.InspectRequest{Key: "dst"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.Version, 2)
+	assertEqual(t, info.Lifespan.AsDuration(), time.Hour)
+
+	revision, err := client.GetRevision(
+		ctx,
+		&pb.GetRevisionRequest{Key: "dst", Version: 1},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, string(revision.Value), "v1")
+
+	// the destination already exists
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "other", Value: []byte("x")})
+	fatalOnErr(t, err)
+	_, err = client.Rename(
+		ctx,
+		&pb.RenameRequest{Source: "dst", Destination: "other"},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	rv, err = client.Rename(
+		ctx,
+		&pb.RenameRequest{Source: "dst", Destination: "other", Overwrite: true},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Version, 2)
+	kv, err = client.Get(ctx, &pb.Key{Key: "other"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), "v2")
+
+	// either key being locked by another client
+	_, err = otherClient.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:             "locked",
+			Duration:        durationpb.New(time.Hour),
+			CreateIfMissing: true,
+		},
+	)
+	fatalOnErr(t, err)
+	_, err = client.Rename(
+		ctx,
+		&pb.RenameRequest{Source: "other", Destination: "locked", Overwrite: true},
+	)
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+	_, err = client.Rename(
+		ctx,
+		&pb.RenameRequest{Source: "locked", Destination: "unlocked"},
+	)
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+
+	_, err = client.Rename(
+		ctx,
+		&pb.RenameRequest{Source: "other", Destination: "other"},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+	_, err = client.Rename(
+		ctx,
+		&pb.RenameRequest{Source: "missing", Destination: "x"},
+	)
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+}
+
+func TestCopy(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+	startingKeyCount := srv.numKeys.Load()
+
+	_, err := client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:         "a",
+			Value:       []byte("foo"),
+			ContentType: "text/x-foo",
+			Lifespan:    durationpb.New(time.Hour),
+		},
+	)
+	fatalOnErr(t, err)
+
+	rv, err := client.Copy(ctx, &pb.CopyRequest{Source: "a", Destination: "b"})
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Version, 1)
+
+	for _, k := range []string{"a", "b"} {
+		info, e := client.Inspect(
+			ctx,
+			&pb.InspectRequest{Key: k, IncludeValue: true},
+		)
+		fatalOnErr(t, e)
+		assertEqual(t, string(info.Value), "foo")
+		assertEqual(t, info.ContentType, "text/x-foo")
+		assertEqual(t, info.Lifespan.AsDuration(), time.Hour)
+	}
+
+	_, err = client.Copy(ctx, &pb.CopyRequest{Source: "a", Destination: "b"})
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "a", Value: []byte("bar")})
+	fatalOnErr(t, err)
+	rv, err = client.Copy(
+		ctx,
+		&pb.CopyRequest{Source: "a", Destination: "b", Overwrite: true},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Version, 2)
+	kv, err := client.Get(ctx, &pb.Key{Key: "b"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), "bar")
+	assertEqual(t, srv.numKeys.Load(), startingKeyCount+2)
+
+	// The destination is bound to the source's lease, in place of its own
+	reg, err := client.Register(
+		ctx,
+		&pb.RegisterRequest{LeaseTtl: durationpb.New(time.Hour)},
+	)
+	fatalOnErr(t, err)
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{Key: "c", Value: []byte("baz"), LeaseId: reg.LeaseId},
+	)
+	fatalOnErr(t, err)
+	_, err = client.Copy(
+		ctx,
+		&pb.CopyRequest{Source: "c", Destination: "b", Overwrite: true},
+	)
+	fatalOnErr(t, err)
+	info, err := client.Inspect(ctx, &pb.InspectRequest{Key: "b"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.LeaseId, reg.LeaseId)
+	srv.leaseMu.RLock()
+	_, bound := srv.leases[reg.LeaseId].Keys["b"]
+	srv.leaseMu.RUnlock()
+	assertEqual(t, bound, true)
+
+	_, err = client.Copy(
+		ctx,
+		&pb.CopyRequest{Source: "a", Destination: "b", Overwrite: true},
+	)
+	fatalOnErr(t, err)
+	info, err = client.Inspect(ctx, &pb.InspectRequest{Key: "b"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.LeaseId, "")
+	srv.leaseMu.RLock()
+	_, bound = srv.leases[reg.LeaseId].Keys["b"]
+	srv.leaseMu.RUnlock()
+	assertEqual(t, bound, false)
+
+	// Only regular values are versioned
+	_, err = client.ListPush(
+		ctx,
+		&pb.ListPushRequest{Key: "list", Values: [][]byte{[]byte("x")}},
+	)
+	fatalOnErr(t, err)
+	_, err = client.Copy(
+		ctx,
+		&pb.CopyRequest{Source: "list", Destination: "list-copy"},
+	)
+	fatalOnErr(t, err)
+	srv.hmu.RLock()
+	assertEqual(t, len(srv.history["list-copy"]), 0)
+	srv.hmu.RUnlock()
+
+	// Overwriting a destination with the source's history doesn't take
+	// its version backwards
+	for _, v := range []string{"x", "y", "z"} {
+		_, err = client.Set(ctx, &pb.KeyValue{Key: "newer", Value: []byte(v)})
+		fatalOnErr(t, err)
+	}
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "older", Value: []byte("o")})
+	fatalOnErr(t, err)
+	rv, err = client.Copy(
+		ctx,
+		&pb.CopyRequest{
+			Source:         "older",
+			Destination:    "newer",
+			Overwrite:      true,
+			IncludeHistory: true,
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Version, 4)
+	info, err = client.Inspect(ctx, &pb.InspectRequest{Key: "newer"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.Version, 4)
+	revisions, err := client.ListRevisions(ctx, &pb.Key{Key: "newer"})
+	fatalOnErr(t, err)
+	assertEqual(t, revisions.Revisions[len(revisions.Revisions)-1].Version, 4)
+}
+
+func TestLabelSelector(t *testing.T) {
+	labels := map[string]string{
+		"env":                "prod",
+		"tier":               "web",
+		"example.com/owner":  "payments",
+		"empty":              "",
+		"with-dash_and.dots": "x",
+	}
+
+	matching := []string{
+		"",
+		"env",
+		"!missing",
+		"env=prod",
+		"env==prod",
+		"env!=dev",
+		"missing!=dev",
+		"empty=",
+		"tier in (web, api)",
+		"tier notin (api)",
+		"missing notin (api)",
+		"example.com/owner=payments",
+		" env = prod , tier in(web) ,with-dash_and.dots ",
+	}
+	for _, selector := range matching {
+		sel, err := parseLabelSelector(selector)
+		fatalOnErr(t, err)
+		if !sel.Matches(labels) {
+			t.Errorf("expected %q to match", selector)
+		}
+	}
+
+	notMatching := []string{
+		"missing",
+		"!env",
+		"env=dev",
+		"env!=prod",
+		"tier in (api)",
+		"missing in (web)",
+		"tier notin (web,api)",
+		"env=prod,tier=api",
+	}
+	for _, selector := range notMatching {
+		sel, err := parseLabelSelector(selector)
+		fatalOnErr(t, err)
+		if sel.Matches(labels) {
+			t.Errorf("expected %q not to match", selector)
+		}
+	}
+
+	invalid := []string{
+		"=prod",
+		"env=prod,",
+		"env prod",
+		"env in web",
+		"env in (web",
+		"-env=prod",
+		"env=-prod",
+		"Example.com/owner=x",
+	}
+	for _, selector := range invalid {
+		_, err := parseLabelSelector(selector)
+		if err == nil {
+			t.Errorf("expected error for %q", selector)
+			continue
+		}
+		assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestLabels(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+
+	keyLabels := map[string]map[string]string{
+		"web-prod": {"env": "prod", "tier": "web"},
+		"api-prod": {"env": "prod", "tier": "api"},
+		"web-dev":  {"env": "dev", "tier": "web"},
+		"none":     nil,
+	}
+	for k, labels := range keyLabels {
+		_, err := client.Set(
+			ctx,
+			&pb.KeyValue{Key: k, Value: []byte(k), Labels: labels},
+		)
+		fatalOnErr(t, err)
+	}
+
+	info, err := client.Inspect(ctx, &pb.InspectRequest{Key: "web-prod"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.Labels["env"], "prod")
+	assertEqual(t, info.Labels["tier"], "web")
+
+	// Labels are kept when they aren't provided
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{Key: "web-prod", Value: []byte("updated")},
+	)
+	fatalOnErr(t, err)
+	info, err = client.Inspect(ctx, &pb.InspectRequest{Key: "web-prod"})
+	fatalOnErr(t, err)
+	assertEqual(t, len(info.Labels), 2)
+
+	// clear_labels replaces existing labels, even with none
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:    "cleared",
+			Value:  []byte("cleared"),
+			Labels: map[string]string{"owner": "x", "team": "y"},
+		},
+	)
+	fatalOnErr(t, err)
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:         "cleared",
+			Value:       []byte("cleared"),
+			Labels:      map[string]string{"owner": "z"},
+			ClearLabels: true,
+		},
+	)
+	fatalOnErr(t, err)
+	info, err = client.Inspect(ctx, &pb.InspectRequest{Key: "cleared"})
+	fatalOnErr(t, err)
+	assertEqual(t, len(info.Labels), 1)
+	assertEqual(t, info.Labels["owner"], "z")
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:         "cleared",
+			Value:       []byte("cleared"),
+			ClearLabels: true,
+		},
+	)
+	fatalOnErr(t, err)
+	info, err = client.Inspect(ctx, &pb.InspectRequest{Key: "cleared"})
+	fatalOnErr(t, err)
+	assertEqual(t, len(info.Labels), 0)
+	keys, err := client.ListKeys(ctx, &pb.ListKeysRequest{Selector: "owner"})
+	fatalOnErr(t, err)
+	assertEqual(t, len(keys.Keys), 0)
+
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:    "bad",
+			Value:  []byte("bad"),
+			Labels: map[string]string{"env": "not valid"},
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	keys, err = client.ListKeys(
+		ctx,
+		&pb.ListKeysRequest{Selector: "env=prod"},
+	)
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, keys.Keys, []string{"api-prod", "web-prod"})
+
+	keys, err = client.ListKeys(
+		ctx,
+		&pb.ListKeysRequest{Selector: "tier=web,env!=prod"},
+	)
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, keys.Keys, []string{"web-dev"})
+
+	_, err = client.ListKeys(ctx, &pb.ListKeysRequest{Selector: "env in"})
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	// Events are only sent for keys matching the selector, including
+	// a key which no longer exists, but matched before it was deleted
+	watchCtx, watchCancel := context.WithCancel(ctx)
+	defer watchCancel()
+	stream, err := client.WatchStream(
+		watchCtx,
+		&pb.WatchRequest{Selector: "env=dev"},
+	)
+	fatalOnErr(t, err)
+	for srv.numEventSubscribers.Load() < 1 {
+		time.Sleep(50 * time.Millisecond)
+	}
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "api-prod", Value: []byte("x")})
+	fatalOnErr(t, err)
+	_, err = client.Delete(ctx, &pb.DeleteRequest{Key: "web-dev"})
+	fatalOnErr(t, err)
+	ev, err := stream.Recv()
+	fatalOnErr(t, err)
+	assertEqual(t, ev.Key, "web-dev")
+	assertEqual(t, ev.Event, pb.KeyEvent_DELETED)
+
+	cleared, err := client.Clear(
+		ctx,
+		&pb.ClearRequest{Selector: "tier=web"},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, cleared.KeysDeleted, 1)
+	_, err = client.Get(ctx, &pb.Key{Key: "web-prod"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+	_, err = client.Get(ctx, &pb.Key{Key: "api-prod"})
+	fatalOnErr(t, err)
+}
+
+func TestExpireAt(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+
+	expireAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	_, err := client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:      "k",
+			Value:    []byte("v1"),
+			ExpireAt: timestamppb.New(expireAt),
+		},
+	)
+	fatalOnErr(t, err)
+
+	info, err := client.Inspect(ctx, &pb.InspectRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.ExpiresAt.AsTime(), expireAt.UTC())
+	if remaining := info.Remaining.AsDuration(); remaining <= 0 || remaining > time.Hour {
+		t.Errorf("unexpected remaining time: %s", remaining)
+	}
+
+	// Updates don't extend an absolute expiration time
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "k", Value: []byte("v2")})
+	fatalOnErr(t, err)
+	info, err = client.Inspect(ctx, &pb.InspectRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.ExpiresAt.AsTime(), expireAt.UTC())
+
+	// ...but a new lifespan replaces it
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:      "k",
+			Value:    []byte("v3"),
+			Lifespan: durationpb.New(2 * time.Hour),
+		},
+	)
+	fatalOnErr(t, err)
+	info, err = client.Inspect(ctx, &pb.InspectRequest{Key: "k"})
+	fatalOnErr(t, err)
+	if !info.ExpiresAt.AsTime().After(expireAt) {
+		t.Errorf("expected expiration to be after %s", expireAt)
+	}
+
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:      "k",
+			Value:    []byte("v4"),
+			Lifespan: durationpb.New(time.Hour),
+			ExpireAt: timestamppb.New(expireAt),
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:      "k",
+			Value:    []byte("v4"),
+			ExpireAt: timestamppb.New(time.Now().Add(-time.Minute)),
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:      "short",
+			Value:    []byte("short"),
+			ExpireAt: timestamppb.New(time.Now().Add(2 * time.Second)),
+		},
+	)
+	fatalOnErr(t, err)
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		_, err = client.Get(ctx, &pb.Key{Key: "short"})
+		if status.Code(err) == codes.NotFound {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected key to expire")
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+}
+
+func TestPersist(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+	otherClient := newClient(t, srv, lis, "someotherclientid")
+
+	_, err := client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:      "k",
+			Value:    []byte("v"),
+			Lifespan: durationpb.New(time.Hour),
+		},
+	)
+	fatalOnErr(t, err)
+
+	watchCtx, watchCancel := context.WithCancel(ctx)
+	defer watchCancel()
+	stream, err := client.WatchStream(
+		watchCtx,
+		&pb.WatchRequest{Events: []pb.KeyEvent{pb.KeyEvent_LIFESPAN_CLEARED}},
+	)
+	fatalOnErr(t, err)
+	for srv.numEventSubscribers.Load() < 1 {
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	rv, err := client.Persist(ctx, &pb.PersistRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Persisted, true)
+
+	ev, err := stream.Recv()
+	fatalOnErr(t, err)
+	assertEqual(t, ev.Key, "k")
+
+	info, err := client.Inspect(
+		ctx,
+		&pb.InspectRequest{Key: "k", IncludeValue: true},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, string(info.Value), "v")
+	assertEqual(t, info.Version, 1)
+	if info.Lifespan != nil || info.ExpiresAt != nil || info.Remaining != nil {
+		t.Errorf("expected no lifespan, got: %v", info)
+	}
+
+	rv, err = client.Persist(ctx, &pb.PersistRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Persisted, false)
+
+	_, err = client.Persist(ctx, &pb.PersistRequest{Key: "missing"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	_, err = otherClient.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:      "k",
+			Duration: durationpb.New(time.Hour),
+			Mode:     pb.LockMode_SHARED,
+		},
+	)
+	fatalOnErr(t, err)
+	_, err = client.Persist(ctx, &pb.PersistRequest{Key: "k"})
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+	assertEqual(t, status.Convert(err).Message(), ErrSharedLocked.Message)
+
+	_, err = otherClient.Lock(
+		ctx,
+		&pb.LockRequest{Key: "k", Duration: durationpb.New(time.Hour)},
+	)
+	fatalOnErr(t, err)
+	_, err = client.Persist(ctx, &pb.PersistRequest{Key: "k"})
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+	assertEqual(t, status.Convert(err).Message(), ErrLocked.Message)
+}
+
+func TestSharedLocks(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	clientA := newClient(t, srv, lis, "client-a")
+	clientB := newClient(t, srv, lis, "client-b")
+	clientC := newClient(t, srv, lis, "client-c")
+
+	_, err := clientA.Set(ctx, &pb.KeyValue{Key: "k", Value: []byte("v")})
+	fatalOnErr(t, err)
+
+	sharedLock := &pb.LockRequest{
+		Key:      "k",
+		Duration: durationpb.New(time.Hour),
+		Mode:     pb.LockMode_SHARED,
+	}
+	exclusiveLock := &pb.LockRequest{
+		Key:      "k",
+		Duration: durationpb.New(time.Hour),
+		Mode:     pb.LockMode_EXCLUSIVE,
+	}
+
+	_, err = clientA.Lock(ctx, sharedLock)
+	fatalOnErr(t, err)
+	_, err = clientB.Lock(ctx, sharedLock)
+	fatalOnErr(t, err)
+	assertEqual(t, srv.numLocks.Load(), 2)
+
+	info, err := clientC.Inspect(ctx, &pb.InspectRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, *info.Locked, true)
+	assertEqual(t, len(info.LockHolders), 2)
+	assertEqual(t, info.LockHolders[0].ClientId, "client-a")
+	assertEqual(t, info.LockHolders[0].Mode, pb.LockMode_SHARED)
+	assertEqual(t, info.LockHolders[1].ClientId, "client-b")
+	assertEqual(t, info.LockHolders[1].Mode, pb.LockMode_SHARED)
+
+	// Reads are allowed, by holders and other clients
+	for _, c := range []*kclient.Client{clientA, clientB, clientC} {
+		rv, e := c.Get(ctx, &pb.Key{Key: "k"})
+		fatalOnErr(t, e)
+		assertEqual(t, string(rv.Value), "v")
+	}
+
+	// Writes aren't, even by a holder, while another client holds
+	// a shared lock
+	_, err = clientA.Set(ctx, &pb.KeyValue{Key: "k", Value: []byte("v2")})
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+	_, err = clientC.Delete(ctx, &pb.DeleteRequest{Key: "k"})
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+
+	// Exclusive locks wait for other shared holders to release
+	_, err = clientC.Lock(ctx, exclusiveLock)
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+	_, err = clientA.Lock(ctx, exclusiveLock)
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+
+	_, err = clientC.Unlock(ctx, &pb.UnlockRequest{Key: "k"})
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+	_, err = clientB.Unlock(ctx, &pb.UnlockRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, srv.numLocks.Load(), 1)
+
+	// The only shared holder can write, and upgrade to an exclusive lock
+	_, err = clientA.Set(ctx, &pb.KeyValue{Key: "k", Value: []byte("v2")})
+	fatalOnErr(t, err)
+	_, err = clientA.Lock(ctx, exclusiveLock)
+	fatalOnErr(t, err)
+	assertEqual(t, srv.numLocks.Load(), 1)
+
+	info, err = clientC.Inspect(ctx, &pb.InspectRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, len(info.LockHolders), 1)
+	assertEqual(t, info.LockHolders[0].ClientId, "client-a")
+	assertEqual(t, info.LockHolders[0].Mode, pb.LockMode_EXCLUSIVE)
+
+	_, err = clientB.Get(ctx, &pb.Key{Key: "k"})
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+	_, err = clientB.Lock(ctx, sharedLock)
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+
+	// ...and downgrade back to a shared lock
+	_, err = clientA.Lock(ctx, sharedLock)
+	fatalOnErr(t, err)
+	_, err = clientB.Lock(ctx, sharedLock)
+	fatalOnErr(t, err)
+	assertEqual(t, srv.numLocks.Load(), 2)
+
+	// Each holder has its own timer
+	_, err = clientC.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:      "k",
+			Duration: durationpb.New(time.Second),
+			Mode:     pb.LockMode_SHARED,
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, srv.numLocks.Load(), 3)
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		info, err = clientA.Inspect(ctx, &pb.InspectRequest{Key: "k"})
+		fatalOnErr(t, err)
+		if len(info.LockHolders) == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected client-c's shared lock to be released")
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+	assertEqual(t, info.LockHolders[0].ClientId, "client-a")
+	assertEqual(t, info.LockHolders[1].ClientId, "client-b")
+	assertEqual(t, srv.numLocks.Load(), 2)
+}
+
+func TestSharedLockCreateIfMissing(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "")
+
+	_, err := client.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:             "k",
+			Duration:        durationpb.New(time.Hour),
+			CreateIfMissing: true,
+			Mode:            pb.LockMode_SHARED,
+		},
+	)
+	fatalOnErr(t, err)
+
+	info, err := client.Inspect(ctx, &pb.InspectRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, len(info.LockHolders), 1)
+	assertEqual(t, info.LockHolders[0].Mode, pb.LockMode_SHARED)
+
+	// Deleting the key releases its locks
+	_, err = client.Delete(ctx, &pb.DeleteRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, srv.numLocks.Load(), 0)
+	assertEqual(t, len(srv.sharedLocks), 0)
+}
+
+func TestFencingTokens(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	clientA := newClient(t, srv, lis, "client-a")
+	clientB := newClient(t, srv, lis, "client-b")
+
+	_, err := clientA.Set(ctx, &pb.KeyValue{Key: "k", Value: []byte("v")})
+	fatalOnErr(t, err)
+
+	rv, err := clientA.Lock(
+		ctx,
+		&pb.LockRequest{Key: "k", Duration: durationpb.New(time.Second)},
+	)
+	fatalOnErr(t, err)
+	staleToken := rv.FencingToken
+	assertEqual(t, staleToken, 1)
+
+	// Wait for client-a's lock to lapse, and client-b to take it over
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		rv, err = clientB.Lock(
+			ctx,
+			&pb.LockRequest{Key: "k", Duration: durationpb.New(time.Hour)},
+		)
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected client-a's lock to expire")
+		}
+		time.Sleep(250 * time.Millisecond)
+	}
+	currentToken := rv.FencingToken
+	assertEqual(t, currentToken, 2)
+
+	// Renewing a lock keeps its token
+	rv, err = clientB.Lock(
+		ctx,
+		&pb.LockRequest{Key: "k", Duration: durationpb.New(time.Hour)},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.FencingToken, currentToken)
+
+	_, err = clientB.Unlock(ctx, &pb.UnlockRequest{Key: "k"})
+	fatalOnErr(t, err)
+
+	// The stale holder is rejected, even though the key is unlocked
+	_, err = clientA.Set(
+		ctx,
+		&pb.KeyValue{Key: "k", Value: []byte("v2"), FencingToken: &staleToken},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	_, err = clientA.Delete(
+		ctx,
+		&pb.DeleteRequest{Key: "k", FencingToken: &staleToken},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	_, err = clientA.Pop(
+		ctx,
+		&pb.PopRequest{Key: "k", FencingToken: &staleToken},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	_, err = clientB.Set(
+		ctx,
+		&pb.KeyValue{Key: "k", Value: []byte("v2"), FencingToken: &currentToken},
+	)
+	fatalOnErr(t, err)
+	getRv, err := clientB.Get(ctx, &pb.Key{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(getRv.Value), "v2")
+
+	// Tokens are removed with the key, but tokens from before it was
+	// deleted are still rejected, and new tokens keep increasing
+	_, err = clientB.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:             "other",
+			Duration:        durationpb.New(time.Hour),
+			CreateIfMissing: true,
+		},
+	)
+	fatalOnErr(t, err)
+	_, err = clientB.Delete(
+		ctx,
+		&pb.DeleteRequest{Key: "k", FencingToken: &currentToken},
+	)
+	fatalOnErr(t, err)
+	srv.lockMu.RLock()
+	_, tokenExists := srv.fencingTokens["k"]
+	srv.lockMu.RUnlock()
+	assertEqual(t, tokenExists, false)
+	for _, token := range []*uint64{&staleToken, &currentToken} {
+		_, err = clientA.Set(
+			ctx,
+			&pb.KeyValue{Key: "k", Value: []byte("v3"), FencingToken: token},
+		)
+		assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	}
+	_, err = clientA.Set(ctx, &pb.KeyValue{Key: "k", Value: []byte("v3")})
+	fatalOnErr(t, err)
+	rv, err = clientA.Lock(
+		ctx,
+		&pb.LockRequest{Key: "k", Duration: durationpb.New(time.Hour)},
+	)
+	fatalOnErr(t, err)
+	currentToken = rv.FencingToken
+	assertEqual(t, currentToken, 4)
+
+	// ...and in snapshots
+	srv.mu.RLock()
+	srv.lockMu.RLock()
+	data, err := srv.MarshalJSON()
+	srv.lockMu.RUnlock()
+	srv.mu.RUnlock()
+	fatalOnErr(t, err)
+
+	cfg := NewConfig()
+	cfg.Logger = srv.logger
+	restored, err := New(cfg)
+	fatalOnErr(t, err)
+	fatalOnErr(t, restored.UnmarshalJSON(data))
+	assertEqual(t, restored.fencingTokens["k"], currentToken)
+	assertEqual(t, restored.fencingToken, currentToken)
+
+	// Snapshots with tokens for deleted keys only keep the last token
+	// issued
+	data, err = json.Marshal(
+		kvStoreState{FencingTokens: map[string]uint64{"deleted": 7}},
+	)
+	fatalOnErr(t, err)
+	restored, err = New(cfg)
+	fatalOnErr(t, err)
+	fatalOnErr(t, restored.UnmarshalJSON(data))
+	assertEqual(t, len(restored.fencingTokens), 0)
+	assertEqual(t, restored.fencingToken, 7)
+}
+
+func TestLockWaitQueue(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	clientA := newClient(t, srv, lis, "client-a")
+	clientB := newClient(t, srv, lis, "client-b")
+	clientC := newClient(t, srv, lis, "client-c")
+	clientD := newClient(t, srv, lis, "client-d")
+
+	_, err := clientA.Set(ctx, &pb.KeyValue{Key: "k", Value: []byte("v")})
+	fatalOnErr(t, err)
+	_, err = clientA.Lock(
+		ctx,
+		&pb.LockRequest{Key: "k", Duration: durationpb.New(time.Hour)},
+	)
+	fatalOnErr(t, err)
+
+	waitForQueueDepth := func(depth uint64) {
+		t.Helper()
+		deadline := time.Now().Add(10 * time.Second)
+		for {
+			info, e := clientA.Inspect(ctx, &pb.InspectRequest{Key: "k"})
+			fatalOnErr(t, e)
+			if info.LockQueueDepth == depth {
+				return
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf(
+					"expected queue depth %d, got %d",
+					depth,
+					info.LockQueueDepth,
+				)
+			}
+			time.Sleep(50 * time.Millisecond)
+		}
+	}
+
+	lockResults := make(chan string, 2)
+	waitForLock := func(c *kclient.Client, name string, d time.Duration) {
+		_, e := c.Lock(
+			ctx,
+			&pb.LockRequest{
+				Key:         "k",
+				Duration:    durationpb.New(d),
+				WaitTimeout: durationpb.New(10 * time.Second),
+			},
+		)
+		if e != nil {
+			t.Errorf("%s: unexpected error waiting for lock: %s", name, e)
+		}
+		lockResults <- name
+	}
+
+	go waitForLock(clientB, "client-b", time.Hour)
+	waitForQueueDepth(1)
+	go waitForLock(clientC, "client-c", 3*time.Second)
+	waitForQueueDepth(2)
+	assertEqual(t, *srv.GetStats().LockWaiters, 2)
+
+	// Other clients can't jump the queue
+	_, err = clientD.Lock(
+		ctx,
+		&pb.LockRequest{Key: "k", Duration: durationpb.New(time.Hour)},
+	)
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+
+	// Waiters are granted the lock in order
+	_, err = clientA.Unlock(ctx, &pb.UnlockRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, <-lockResults, "client-b")
+	waitForQueueDepth(1)
+
+	_, err = clientB.Unlock(ctx, &pb.UnlockRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, <-lockResults, "client-c")
+	waitForQueueDepth(0)
+	assertEqual(t, *srv.GetStats().LockWaiters, 0)
+
+	// Timing out removes the waiter from the queue
+	_, err = clientD.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:         "k",
+			Duration:    durationpb.New(time.Hour),
+			WaitTimeout: durationpb.New(100 * time.Millisecond),
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.DeadlineExceeded)
+	waitForQueueDepth(0)
+
+	// ...as does cancelling the request
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancelled := make(chan error, 1)
+	go func() {
+		_, e := clientD.Lock(
+			cancelCtx,
+			&pb.LockRequest{
+				Key:         "k",
+				Duration:    durationpb.New(time.Hour),
+				WaitTimeout: durationpb.New(10 * time.Second),
+			},
+		)
+		cancelled <- e
+	}()
+	waitForQueueDepth(1)
+	cancel()
+	assertErrorCode(t, status.Code(<-cancelled), codes.Canceled)
+	waitForQueueDepth(0)
+
+	// The lock is also granted when the current lock expires
+	_, err = clientD.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:         "k",
+			Duration:    durationpb.New(time.Hour),
+			WaitTimeout: durationpb.New(10 * time.Second),
+		},
+	)
+	fatalOnErr(t, err)
+	info, err := clientA.Inspect(ctx, &pb.InspectRequest{Key: "k"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.LockHolders[0].ClientId, "client-d")
+}
+
+func TestElection(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	clientA := newClient(t, srv, lis, "client-a")
+	clientB := newClient(t, srv, lis, "client-b")
+
+	_, err := clientA.Leader(ctx, &pb.LeaderRequest{Name: "e"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+	_, err = clientA.Campaign(
+		ctx,
+		&pb.CampaignRequest{Value: []byte("a"), Lease: durationpb.New(time.Hour)},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	observeCtx, cancelObserve := context.WithCancel(ctx)
+	defer cancelObserve()
+	stream, err := clientB.ObserveLeader(
+		observeCtx,
+		&pb.LeaderRequest{Name: "e"},
+	)
+	fatalOnErr(t, err)
+	nextLeader := func() *pb.LeaderResponse {
+		t.Helper()
+		leader, e := stream.Recv()
+		fatalOnErr(t, e)
+		return leader
+	}
+	assertEqual(t, nextLeader().ClientId, "")
+
+	rv, err := clientA.Campaign(
+		ctx,
+		&pb.CampaignRequest{
+			Name:  "e",
+			Value: []byte("a"),
+			Lease: durationpb.New(time.Hour),
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Leader, true)
+	firstToken := rv.FencingToken
+
+	leader, err := clientB.Leader(ctx, &pb.LeaderRequest{Name: "e"})
+	fatalOnErr(t, err)
+	assertEqual(t, leader.ClientId, "client-a")
+	assertEqual(t, string(leader.Value), "a")
+	assertEqual(t, leader.FencingToken, firstToken)
+
+	observed := nextLeader()
+	assertEqual(t, observed.ClientId, "client-a")
+	assertEqual(t, string(observed.Value), "a")
+
+	// The election key isn't visible to clients
+	_, err = clientB.Get(ctx, &pb.Key{Key: "e"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	// Another candidate waits until the leader resigns
+	elected := make(chan *pb.CampaignResponse, 1)
+	go func() {
+		resp, e := clientB.Campaign(
+			ctx,
+			&pb.CampaignRequest{
+				Name:        "e",
+				Value:       []byte("b"),
+				Lease:       durationpb.New(2 * time.Second),
+				WaitTimeout: durationpb.New(10 * time.Second),
+			},
+		)
+		if e != nil {
+			t.Errorf("unexpected error campaigning: %s", e)
+		}
+		elected <- resp
+	}()
+	deadline := time.Now().Add(10 * time.Second)
+	for *srv.GetStats().LockWaiters != 1 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected candidate to be waiting")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// Only the leader can resign
+	resigned, err := clientB.Resign(ctx, &pb.ResignRequest{Name: "e"})
+	fatalOnErr(t, err)
+	assertEqual(t, resigned.Resigned, false)
+
+	resigned, err = clientA.Resign(ctx, &pb.ResignRequest{Name: "e"})
+	fatalOnErr(t, err)
+	assertEqual(t, resigned.Resigned, true)
+
+	rv = <-elected
+	if rv == nil {
+		t.Fatalf("expected client-b to be elected")
+	}
+	assertEqual(t, rv.Leader, true)
+	if rv.FencingToken <= firstToken {
+		t.Errorf(
+			"expected fencing token greater than %d, got %d",
+			firstToken,
+			rv.FencingToken,
+		)
+	}
+
+	observed = nextLeader()
+	if observed.ClientId == "" {
+		observed = nextLeader()
+	}
+	assertEqual(t, observed.ClientId, "client-b")
+	assertEqual(t, string(observed.Value), "b")
+
+	// Leadership ends when the lease expires
+	assertEqual(t, nextLeader().ClientId, "")
+	_, err = clientA.Leader(ctx, &pb.LeaderRequest{Name: "e"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+}
+
+func TestSemaphores(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	clientA := newClient(t, srv, lis, "client-a")
+	clientB := newClient(t, srv, lis, "client-b")
+	clientC := newClient(t, srv, lis, "client-c")
+
+	acquire := func(c *kclient.Client, permitsMax uint32, ttl time.Duration) (
+		*pb.AcquireSemaphoreResponse,
+		error,
+	) {
+		return c.AcquireSemaphore(
+			ctx,
+			&pb.AcquireSemaphoreRequest{
+				Name:       "sem",
+				PermitsMax: permitsMax,
+				Ttl:        durationpb.New(ttl),
+			},
+		)
+	}
+
+	_, err := acquire(clientA, 0, time.Hour)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	// The key is created with the first permit
+	rv, err := acquire(clientA, 2, time.Hour)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Available, 1)
+
+	rv, err = acquire(clientB, 2, 2*time.Second)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Available, 0)
+
+	_, err = acquire(clientC, 2, time.Hour)
+	assertErrorCode(t, status.Code(err), codes.ResourceExhausted)
+	_, err = acquire(clientC, 3, time.Hour)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	// Holders can renew their permit
+	_, err = acquire(clientA, 2, time.Hour)
+	fatalOnErr(t, err)
+
+	info, err := clientC.Inspect(ctx, &pb.InspectRequest{Key: "sem"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.Semaphore.PermitsMax, 2)
+	assertEqual(t, info.Semaphore.Available, 0)
+	assertEqual(t, len(info.Semaphore.Holders), 2)
+	assertEqual(t, info.Semaphore.Holders[0].ClientId, "client-a")
+	assertEqual(t, info.Semaphore.Holders[1].ClientId, "client-b")
+
+	// Permits expire on their own timers
+	time.Sleep(3 * time.Second)
+	info, err = clientC.Inspect(ctx, &pb.InspectRequest{Key: "sem"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.Semaphore.Available, 1)
+	assertEqual(t, info.Semaphore.Holders[0].ClientId, "client-a")
+
+	rv, err = acquire(clientC, 2, time.Hour)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Available, 0)
+
+	released, err := clientB.ReleaseSemaphore(
+		ctx,
+		&pb.ReleaseSemaphoreRequest{Name: "sem"},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, released.Released, false)
+
+	released, err = clientC.ReleaseSemaphore(
+		ctx,
+		&pb.ReleaseSemaphoreRequest{Name: "sem"},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, released.Released, true)
+	assertEqual(t, released.Available, 1)
+
+	// Semaphores are kept in snapshots
+	srv.mu.RLock()
+	srv.lockMu.RLock()
+	data, err := srv.MarshalJSON()
+	srv.lockMu.RUnlock()
+	srv.mu.RUnlock()
+	fatalOnErr(t, err)
+
+	cfg := NewConfig()
+	cfg.Logger = srv.logger
+	restored, err := New(cfg)
+	fatalOnErr(t, err)
+	fatalOnErr(t, restored.UnmarshalJSON(data))
+	restored.lockMu.Lock()
+	sem := restored.semaphores["sem"]
+	restored.lockMu.Unlock()
+	assertEqual(t, sem.PermitsMax, 2)
+	assertEqual(t, sem.Permits["client-a"].Permit, true)
+
+	// The semaphore is removed when its key is deleted
+	_, err = clientA.Delete(ctx, &pb.DeleteRequest{Key: "sem"})
+	fatalOnErr(t, err)
+	srv.lockMu.RLock()
+	_, exists := srv.semaphores["sem"]
+	srv.lockMu.RUnlock()
+	assertEqual(t, exists, false)
+
+	// Permits can't be acquired on a key locked by another client
+	_, err = clientA.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:             "sem",
+			Duration:        durationpb.New(time.Hour),
+			CreateIfMissing: true,
+		},
+	)
+	fatalOnErr(t, err)
+	_, err = acquire(clientB, 2, time.Hour)
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+}
+
+func TestLeases(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	clientA := newClient(t, srv, lis, "client-a")
+	clientB := newClient(t, srv, lis, "client-b")
+
+	// Clients that are already registered can request a lease
+	reg, err := clientA.Register(
+		ctx,
+		&pb.RegisterRequest{LeaseTtl: durationpb.New(2 * time.Second)},
+	)
+	fatalOnErr(t, err)
+	leaseID := reg.LeaseId
+	if leaseID == "" {
+		t.Fatalf("expected lease ID")
+	}
+	_, err = clientB.Register(
+		ctx,
+		&pb.RegisterRequest{LeaseTtl: durationpb.New(time.Millisecond)},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	events, err := srv.Subscribe(
+		ctx,
+		"test-leases",
+		[]string{"session"},
+		[]KeyEvent{Expired},
+	)
+	fatalOnErr(t, err)
+
+	_, err = clientA.Set(
+		ctx,
+		&pb.KeyValue{Key: "session", Value: []byte("a"), LeaseId: leaseID},
+	)
+	fatalOnErr(t, err)
+	_, err = clientA.Set(ctx, &pb.KeyValue{Key: "other", Value: []byte("a")})
+	fatalOnErr(t, err)
+	_, err = clientA.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:      "other",
+			Duration: durationpb.New(time.Hour),
+			LeaseId:  leaseID,
+		},
+	)
+	fatalOnErr(t, err)
+
+	// Unlocking or deleting a key removes it from the lease, so a lock
+	// acquired later without the lease isn't released with it
+	_, err = clientA.Set(
+		ctx,
+		&pb.KeyValue{Key: "unleased", Value: []byte("a"), LeaseId: leaseID},
+	)
+	fatalOnErr(t, err)
+	_, err = clientA.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:      "unleased",
+			Duration: durationpb.New(time.Hour),
+			LeaseId:  leaseID,
+		},
+	)
+	fatalOnErr(t, err)
+	_, err = clientA.Unlock(ctx, &pb.UnlockRequest{Key: "unleased"})
+	fatalOnErr(t, err)
+	_, err = clientA.Delete(ctx, &pb.DeleteRequest{Key: "unleased"})
+	fatalOnErr(t, err)
+	srv.leaseMu.RLock()
+	_, locked := srv.leases[leaseID].Locks["unleased"]
+	_, bound := srv.leases[leaseID].Keys["unleased"]
+	srv.leaseMu.RUnlock()
+	assertEqual(t, locked, false)
+	assertEqual(t, bound, false)
+
+	_, err = clientA.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:          "unleased",
+			Value:        []byte("a"),
+			LockDuration: durationpb.New(time.Hour),
+		},
+	)
+	fatalOnErr(t, err)
+
+	// Leases can only be used by the client they were granted to
+	_, err = clientB.Set(
+		ctx,
+		&pb.KeyValue{Key: "b", Value: []byte("b"), LeaseId: leaseID},
+	)
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+	_, err = clientA.Set(
+		ctx,
+		&pb.KeyValue{Key: "b", Value: []byte("b"), LeaseId: "nope"},
+	)
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	info, err := clientA.Inspect(ctx, &pb.InspectRequest{Key: "session"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.LeaseId, leaseID)
+
+	// The lease is kept alive past its TTL while it's renewed
+	stream, err := clientA.KeepAlive(ctx)
+	fatalOnErr(t, err)
+	for i := 0; i < 3; i++ {
+		fatalOnErr(t, stream.Send(&pb.KeepAliveRequest{LeaseId: leaseID}))
+		resp, e := stream.Recv()
+		fatalOnErr(t, e)
+		assertEqual(t, resp.LeaseId, leaseID)
+		time.Sleep(time.Second)
+	}
+	fatalOnErr(t, stream.CloseSend())
+	_, err = clientA.Get(ctx, &pb.Key{Key: "session"})
+	fatalOnErr(t, err)
+
+	// ...and then its keys are deleted, and locks released
+	select {
+	case ev := <-events:
+		assertEqual(t, ev.Event, Expired)
+		assertEqual(t, ev.ClientID, "client-a")
+	case <-time.After(10 * time.Second):
+		t.Fatalf("timed out waiting for lease to expire")
+	}
+	_, err = clientA.Get(ctx, &pb.Key{Key: "session"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	info, err = clientB.Inspect(ctx, &pb.InspectRequest{Key: "other"})
+	fatalOnErr(t, err)
+	assertEqual(t, *info.Locked, false)
+	info, err = clientB.Inspect(ctx, &pb.InspectRequest{Key: "unleased"})
+	fatalOnErr(t, err)
+	assertEqual(t, *info.Locked, true)
+
+	stream, err = clientA.KeepAlive(ctx)
+	fatalOnErr(t, err)
+	fatalOnErr(t, stream.Send(&pb.KeepAliveRequest{LeaseId: leaseID}))
+	_, err = stream.Recv()
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+}
+
+func TestLists(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "client-a")
+
+	push := func(end pb.ListEnd, values ...string) *pb.ListPushResponse {
+		t.Helper()
+		req := &pb.ListPushRequest{Key: "list", End: end}
+		for _, v := range values {
+			req.Values = append(req.Values, []byte(v))
+		}
+		rv, err := client.ListPush(ctx, req)
+		fatalOnErr(t, err)
+		return rv
+	}
+	listRange := func(start int64, stop int64) []string {
+		t.Helper()
+		rv, err := client.ListRange(
+			ctx,
+			&pb.ListRangeRequest{Key: "list", Start: start, Stop: stop},
+		)
+		fatalOnErr(t, err)
+		values := make([]string, 0, len(rv.Values))
+		for _, v := range rv.Values {
+			values = append(values, string(v))
+		}
+		return values
+	}
+
+	rv := push(pb.ListEnd_RIGHT, "a", "b", "c")
+	assertEqual(t, rv.Length, 3)
+	assertEqual(t, rv.Version, 1)
+	rv = push(pb.ListEnd_LEFT, "y", "z")
+	assertEqual(t, rv.Length, 5)
+	assertEqual(t, rv.Version, 2)
+
+	assertEqual(t, strings.Join(listRange(0, -1), ""), "zyabc")
+	assertEqual(t, strings.Join(listRange(1, 2), ""), "ya")
+	assertEqual(t, strings.Join(listRange(-2, 100), ""), "bc")
+	assertEqual(t, len(listRange(3, 1)), 0)
+
+	length, err := client.ListLen(ctx, &pb.Key{Key: "list"})
+	fatalOnErr(t, err)
+	assertEqual(t, length.Length, 5)
+
+	info, err := client.Inspect(ctx, &pb.InspectRequest{Key: "list"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.ValueType, pb.ValueType_LIST)
+	assertEqual(t, info.Size, 5)
+
+	popped, err := client.ListPop(
+		ctx,
+		&pb.ListPopRequest{Key: "list", End: pb.ListEnd_RIGHT},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, string(popped.Value), "c")
+	assertEqual(t, popped.Length, 4)
+	popped, err = client.ListPop(ctx, &pb.ListPopRequest{Key: "list"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(popped.Value), "z")
+
+	// Other operations don't apply to lists, and vice versa
+	_, err = client.Get(ctx, &pb.Key{Key: "list"})
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	_, err = client.Pop(ctx, &pb.PopRequest{Key: "list"})
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	length, err = client.ListLen(ctx, &pb.Key{Key: "list"})
+	fatalOnErr(t, err)
+	assertEqual(t, length.Length, 3)
+	info, err = client.Inspect(
+		ctx,
+		&pb.InspectRequest{Key: "list", IncludeValue: true},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, len(info.Value), 0)
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "str", Value: []byte("v")})
+	fatalOnErr(t, err)
+	_, err = client.ListPush(
+		ctx,
+		&pb.ListPushRequest{Key: "str", Values: [][]byte{[]byte("v")}},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	// The total size of the list is limited by MaxValueSize
+	srv.cfgMu.Lock()
+	srv.cfg.MaxValueSize = 5
+	srv.cfgMu.Unlock()
+	_, err = client.ListPush(
+		ctx,
+		&pb.ListPushRequest{Key: "list", Values: [][]byte{[]byte("def")}},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	srv.cfgMu.Lock()
+	srv.cfg.MaxValueSize = DefaultMaxValueSize
+	srv.cfgMu.Unlock()
+
+	// Lists are kept in snapshots
+	srv.mu.RLock()
+	srv.lockMu.RLock()
+	data, err := srv.MarshalJSON()
+	srv.lockMu.RUnlock()
+	srv.mu.RUnlock()
+	fatalOnErr(t, err)
+	cfg := NewConfig()
+	cfg.Logger = srv.logger
+	restored, err := New(cfg)
+	fatalOnErr(t, err)
+	fatalOnErr(t, restored.UnmarshalJSON(data))
+	restoredKV := restored.store["list"]
+	assertEqual(t, restoredKV.Type, pb.ValueType_LIST)
+	assertEqual(t, len(restoredKV.List), 3)
+	assertEqual(t, restoredKV.Size, 3)
+
+	// Popping from an empty list can wait for a value to be pushed
+	_, err = client.ListPop(
+		ctx,
+		&pb.ListPopRequest{
+			Key:          "queue",
+			BlockTimeout: durationpb.New(100 * time.Millisecond),
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.DeadlineExceeded)
+
+	results := make(chan string, 1)
+	go func() {
+		resp, e := client.ListPop(
+			ctx,
+			&pb.ListPopRequest{
+				Key:          "queue",
+				BlockTimeout: durationpb.New(10 * time.Second),
+			},
+		)
+		if e != nil {
+			t.Errorf("unexpected error waiting for value: %s", e)
+			results <- ""
+			return
+		}
+		results <- string(resp.Value)
+	}()
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		srv.mu.RLock()
+		waiting := len(srv.listWaiters["queue"])
+		srv.mu.RUnlock()
+		if waiting == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected pop to be waiting")
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	_, err = client.ListPush(
+		ctx,
+		&pb.ListPushRequest{Key: "queue", Values: [][]byte{[]byte("job")}},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, <-results, "job")
+}
+
+func TestHashes(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "client-a")
+
+	rv, err := client.HSet(
+		ctx,
+		&pb.HSetRequest{
+			Key: "config",
+			Fields: map[string][]byte{
+				"host": []byte("localhost"),
+				"port": []byte("8080"),
+			},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Added, 2)
+	assertEqual(t, rv.Version, 1)
+
+	info, err := client.Inspect(ctx, &pb.InspectRequest{Key: "config"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.ValueType, pb.ValueType_HASH)
+	assertEqual(t, info.Size, uint64(len("hostlocalhostport8080")))
+
+	// Field changes are sent to watchers, with the names of the
+	// changed fields
+	watchCtx, watchCancel := context.WithCancel(ctx)
+	defer watchCancel()
+	stream, err := client.WatchKeyValue(
+		watchCtx,
+		&pb.WatchKeyValueRequest{Key: "config"},
+	)
+	fatalOnErr(t, err)
+	current, err := stream.Recv()
+	fatalOnErr(t, err)
+	assertEqual(t, current.Version, 1)
+	for srv.numEventSubscribers.Load() < 1 {
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	rv, err = client.HSet(
+		ctx,
+		&pb.HSetRequest{
+			Key: "config",
+			Fields: map[string][]byte{
+				"port":  []byte("9090"),
+				"debug": []byte("true"),
+			},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Added, 1)
+	assertEqual(t, rv.Version, 2)
+
+	ev, err := stream.Recv()
+	fatalOnErr(t, err)
+	assertEqual(t, ev.KeyEvent, pb.KeyEvent_UPDATED)
+	assertEqual(t, ev.Version, 2)
+	assertEqual(t, strings.Join(ev.ChangedFields, ","), "debug,port")
+
+	field, err := client.HGet(
+		ctx,
+		&pb.HGetRequest{Key: "config", Field: "port"},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, string(field.Value), "9090")
+	_, err = client.HGet(ctx, &pb.HGetRequest{Key: "config", Field: "nope"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	incr, err := client.HIncrBy(
+		ctx,
+		&pb.HIncrByRequest{Key: "config", Field: "port", Amount: 5},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, incr.Value, 9095)
+	assertEqual(t, incr.Version, 3)
+	ev, err = stream.Recv()
+	fatalOnErr(t, err)
+	assertEqual(t, strings.Join(ev.ChangedFields, ","), "port")
+
+	_, err = client.HIncrBy(
+		ctx,
+		&pb.HIncrByRequest{Key: "config", Field: "host", Amount: 1},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	deleted, err := client.HDel(
+		ctx,
+		&pb.HDelRequest{Key: "config", Fields: []string{"debug", "nope"}},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, deleted.Deleted, 1)
+	assertEqual(t, deleted.Version, 4)
+	ev, err = stream.Recv()
+	fatalOnErr(t, err)
+	assertEqual(t, strings.Join(ev.ChangedFields, ","), "debug")
+
+	all, err := client.HGetAll(ctx, &pb.Key{Key: "config"})
+	fatalOnErr(t, err)
+	assertEqual(t, len(all.Fields), 2)
+	assertEqual(t, string(all.Fields["host"]), "localhost")
+	assertEqual(t, string(all.Fields["port"]), "9095")
+
+	info, err = client.Inspect(ctx, &pb.InspectRequest{Key: "config"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.Size, uint64(len("hostlocalhostport9095")))
+
+	// Field-level changes don't add revisions
+	srv.hmu.RLock()
+	revisions := len(srv.history["config"])
+	srv.hmu.RUnlock()
+	assertEqual(t, revisions, 0)
+
+	// Other operations don't apply to hashes, and vice versa
+	_, err = client.Get(ctx, &pb.Key{Key: "config"})
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "str", Value: []byte("v")})
+	fatalOnErr(t, err)
+	_, err = client.HSet(
+		ctx,
+		&pb.HSetRequest{
+			Key:    "str",
+			Fields: map[string][]byte{"a": []byte("b")},
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	// Hashes are kept in snapshots
+	srv.mu.RLock()
+	srv.lockMu.RLock()
+	data, err := srv.MarshalJSON()
+	srv.lockMu.RUnlock()
+	srv.mu.RUnlock()
+	fatalOnErr(t, err)
+	cfg := NewConfig()
+	cfg.Logger = srv.logger
+	restored, err := New(cfg)
+	fatalOnErr(t, err)
+	fatalOnErr(t, restored.UnmarshalJSON(data))
+	restoredKV := restored.store["config"]
+	assertEqual(t, restoredKV.Type, pb.ValueType_HASH)
+	assertEqual(t, string(restoredKV.Fields["port"]), "9095")
+	assertEqual(t, restoredKV.Size, info.Size)
+}
+
+func TestSets(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "client-a")
+
+	added, err := client.SAdd(
+		ctx,
+		&pb.SetMembersRequest{
+			Key:     "registry",
+			Members: []string{"node-b", "node-a", "node-b"},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, added.Added, 2)
+	assertEqual(t, added.Version, 1)
+
+	added, err = client.SAdd(
+		ctx,
+		&pb.SetMembersRequest{
+			Key:     "registry",
+			Members: []string{"node-a", "node-c"},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, added.Added, 1)
+	assertEqual(t, added.Version, 2)
+
+	members, err := client.SMembers(ctx, &pb.Key{Key: "registry"})
+	fatalOnErr(t, err)
+	assertEqual(t, strings.Join(members.Members, ","), "node-a,node-b,node-c")
+
+	isMember, err := client.SIsMember(
+		ctx,
+		&pb.SIsMemberRequest{Key: "registry", Member: "node-b"},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, isMember.IsMember, true)
+
+	removed, err := client.SRem(
+		ctx,
+		&pb.SetMembersRequest{
+			Key:     "registry",
+			Members: []string{"node-b", "node-z"},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, removed.Removed, 1)
+	assertEqual(t, removed.Version, 3)
+
+	isMember, err = client.SIsMember(
+		ctx,
+		&pb.SIsMemberRequest{Key: "registry", Member: "node-b"},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, isMember.IsMember, false)
+
+	info, err := client.Inspect(ctx, &pb.InspectRequest{Key: "registry"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.ValueType, pb.ValueType_SET)
+	assertEqual(t, info.Size, uint64(len("node-anode-c")))
+
+	// Writes are counted in the key's metrics
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		km, e := client.GetKeyMetric(ctx, &pb.KeyMetricRequest{Key: "registry"})
+		fatalOnErr(t, e)
+		if km.SetCount == 3 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("expected set count of 3, got %d", km.SetCount)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	// The total size of the members is limited by MaxValueSize
+	srv.cfgMu.Lock()
+	srv.cfg.MaxValueSize = 15
+	srv.cfgMu.Unlock()
+	_, err = client.SAdd(
+		ctx,
+		&pb.SetMembersRequest{Key: "registry", Members: []string{"node-d"}},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	_, err = client.ZAdd(
+		ctx,
+		&pb.ZAddRequest{
+			Key:     "too-big",
+			Members: []*pb.ZMember{{Member: "abcdefgh", Score: 1}},
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	srv.cfgMu.Lock()
+	srv.cfg.MaxValueSize = DefaultMaxValueSize
+	srv.cfgMu.Unlock()
+
+	// Sorted sets are ordered by score, then by member
+	zadded, err := client.ZAdd(
+		ctx,
+		&pb.ZAddRequest{
+			Key: "queue",
+			Members: []*pb.ZMember{
+				{Member: "c", Score: 3},
+				{Member: "a", Score: 1},
+				{Member: "b", Score: 1},
+				{Member: "d", Score: 10},
+			},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, zadded.Added, 4)
+	zadded, err = client.ZAdd(
+		ctx,
+		&pb.ZAddRequest{
+			Key:     "queue",
+			Members: []*pb.ZMember{{Member: "d", Score: 2}},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, zadded.Added, 0)
+	assertEqual(t, zadded.Version, 2)
+
+	_, err = client.ZAdd(
+		ctx,
+		&pb.ZAddRequest{
+			Key:     "queue",
+			Members: []*pb.ZMember{{Member: "e", Score: math.Inf(1)}},
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	zmembers := func(rv *pb.ZMembersResponse) string {
+		names := make([]string, 0, len(rv.Members))
+		for _, m := range rv.Members {
+			names = append(names, m.Member)
+		}
+		return strings.Join(names, ",")
+	}
+
+	zrange, err := client.ZRangeByScore(
+		ctx,
+		&pb.ZRangeByScoreRequest{Key: "queue", Min: 1, Max: 2},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, zmembers(zrange), "a,b,d")
+	zrange, err = client.ZRangeByScore(
+		ctx,
+		&pb.ZRangeByScoreRequest{
+			Key:   "queue",
+			Min:   math.Inf(-1),
+			Max:   math.Inf(1),
+			Limit: 3,
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, zmembers(zrange), "a,b,d")
+
+	zremoved, err := client.ZRem(
+		ctx,
+		&pb.SetMembersRequest{Key: "queue", Members: []string{"b"}},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, zremoved.Removed, 1)
+
+	popped, err := client.ZPopMin(
+		ctx,
+		&pb.ZPopMinRequest{Key: "queue", Count: 2},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, zmembers(popped), "a,d")
+	assertEqual(t, popped.Members[1].Score, float64(2))
+
+	info, err = client.Inspect(ctx, &pb.InspectRequest{Key: "queue"})
+	fatalOnErr(t, err)
+	assertEqual(t, info.ValueType, pb.ValueType_ZSET)
+	assertEqual(t, info.Size, zMemberSize("c"))
+
+	// Other operations don't apply to sets, and vice versa
+	_, err = client.Get(ctx, &pb.Key{Key: "registry"})
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	_, err = client.ZAdd(
+		ctx,
+		&pb.ZAddRequest{
+			Key:     "registry",
+			Members: []*pb.ZMember{{Member: "a", Score: 1}},
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	// Sets and sorted sets are kept in snapshots
+	srv.mu.RLock()
+	srv.lockMu.RLock()
+	data, err := srv.MarshalJSON()
+	srv.lockMu.RUnlock()
+	srv.mu.RUnlock()
+	fatalOnErr(t, err)
+	cfg := NewConfig()
+	cfg.Logger = srv.logger
+	restored, err := New(cfg)
+	fatalOnErr(t, err)
+	fatalOnErr(t, restored.UnmarshalJSON(data))
+
+	restoredSet := restored.store["registry"]
+	assertEqual(t, restoredSet.Type, pb.ValueType_SET)
+	assertEqual(t, len(restoredSet.Members), 2)
+	assertEqual(t, restoredSet.Size, uint64(len("node-anode-c")))
+	restoredZSet := restored.store["queue"]
+	assertEqual(t, restoredZSet.Type, pb.ValueType_ZSET)
+	assertEqual(t, restoredZSet.Scores["c"], float64(3))
+	assertEqual(t, restoredZSet.Size, zMemberSize("c"))
+}
+
+func TestTopics(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	publisher := newClient(t, srv, lis, "publisher")
+	ordersClient := newClient(t, srv, lis, "orders")
+	otherClient := newClient(t, srv, lis, "other", kclient.WithNamespace("other"))
+
+	watchCtx, watchCancel := context.WithCancel(ctx)
+	defer watchCancel()
+
+	orders, err := ordersClient.SubscribeTopic(
+		watchCtx,
+		&pb.SubscribeTopicRequest{Patterns: []string{"orders.*"}},
+	)
+	fatalOnErr(t, err)
+	other, err := otherClient.SubscribeTopic(
+		watchCtx,
+		&pb.SubscribeTopicRequest{},
+	)
+	fatalOnErr(t, err)
+	for srv.numEventSubscribers.Load() < 2 {
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	_, err = publisher.Publish(
+		ctx,
+		&pb.PublishRequest{Topic: "payments.created", Payload: []byte("p1")},
+	)
+	fatalOnErr(t, err)
+	_, err = publisher.Publish(
+		ctx,
+		&pb.PublishRequest{Topic: "orders.created", Payload: []byte("o1")},
+	)
+	fatalOnErr(t, err)
+
+	msg, err := orders.Recv()
+	fatalOnErr(t, err)
+	assertEqual(t, msg.Topic, "orders.created")
+	assertEqual(t, string(msg.Payload), "o1")
+	assertEqual(t, msg.ClientId, "publisher")
+
+	// Messages are only sent to subscribers in the same namespace
+	_, err = otherClient.Publish(
+		ctx,
+		&pb.PublishRequest{Topic: "orders.created", Payload: []byte("o2")},
+	)
+	fatalOnErr(t, err)
+	msg, err = other.Recv()
+	fatalOnErr(t, err)
+	assertEqual(t, msg.Topic, "orders.created")
+	assertEqual(t, string(msg.Payload), "o2")
+
+	// Messages aren't stored
+	_, err = publisher.Get(ctx, &pb.Key{Key: "orders.created"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	_, err = publisher.Publish(ctx, &pb.PublishRequest{})
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	badPattern, err := publisher.SubscribeTopic(
+		watchCtx,
+		&pb.SubscribeTopicRequest{Patterns: []string{"["}},
+	)
+	fatalOnErr(t, err)
+	_, err = badPattern.Recv()
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+}
+
+func TestJSONDocuments(t *testing.T) {
+	srv, lis := newServer(t, nil, nil)
+	client := newClient(t, srv, lis, "client-a")
+
+	doc := `{"name":"widget","tags":["a","b","c"],"dims":{"w":10,"h":2.5}}`
+	_, err := client.Set(ctx, &pb.KeyValue{Key: "doc", Value: []byte(doc)})
+	fatalOnErr(t, err)
+
+	paths := map[string]string{
+		"":              `{"dims":{"h":2.5,"w":10},"name":"widget","tags":["a","b","c"]}`,
+		"$":             `{"dims":{"h":2.5,"w":10},"name":"widget","tags":["a","b","c"]}`,
+		"$.name":        `"widget"`,
+		"$['dims'].w":   `10`,
+		"$.dims['h']":   `2.5`,
+		"$.tags[0]":     `"a"`,
+		"$.tags[-1]":    `"c"`,
+		`$["tags"][1]`:  `"b"`,
+		"$.tags":        `["a","b","c"]`,
+		"$.dims[\"w\"]": `10`,
+	}
+	for path, expected := range paths {
+		rv, e := client.JSONGet(ctx, &pb.JSONGetRequest{Key: "doc", Path: path})
+		fatalOnErr(t, e)
+		assertEqual(t, string(rv.Value), expected, path)
+	}
+
+	_, err = client.JSONGet(ctx, &pb.JSONGetRequest{Key: "doc", Path: "$.nope"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+	_, err = client.JSONGet(ctx, &pb.JSONGetRequest{Key: "doc", Path: "$.tags[3]"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+	_, err = client.JSONGet(ctx, &pb.JSONGetRequest{Key: "doc", Path: "name"})
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+	_, err = client.JSONGet(ctx, &pb.JSONGetRequest{Key: "nope"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	patch := `[
+		{"op": "test", "path": "/name", "value": "widget"},
+		{"op": "replace", "path": "/name", "value": "gadget"},
+		{"op": "add", "path": "/tags/1", "value": "x"},
+		{"op": "add", "path": "/tags/-", "value": "z"},
+		{"op": "remove", "path": "/tags/0"},
+		{"op": "copy", "from": "/dims", "path": "/size"},
+		{"op": "move", "from": "/dims/w", "path": "/width"},
+		{"op": "add", "path": "/a~1b", "value": {"n": 12345678901234567890}},
+		{"op": "test", "path": "/size/w", "value": 10.0}
+	]`
+	rv, err := client.JSONPatch(
+		ctx,
+		&pb.JSONPatchRequest{Key: "doc", Patch: []byte(patch)},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Version, 2)
+
+	expected := `{"a/b":{"n":12345678901234567890},"dims":{"h":2.5},` +
+		`"name":"gadget","size":{"h":2.5,"w":10},"tags":["x","b","c","z"],` +
+		`"width":10}`
+	kv, err := client.Get(ctx, &pb.Key{Key: "doc"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), expected)
+
+	// The previous value is kept in the key's history, like with Set
+	rev, err := client.GetRevision(
+		ctx,
+		&pb.GetRevisionRequest{Key: "doc", Version: 1},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, string(rev.Value), doc)
+
+	// If any operation fails, none of them are applied
+	failing := map[string]codes.Code{
+		`[{"op": "remove", "path": "/name"}, {"op": "test", "path": "/width", "value": 11}]`: codes.FailedPrecondition,
+		`[{"op": "remove", "path": "/name"}, {"op": "remove", "path": "/nope"}]`:             codes.FailedPrecondition,
+		`[{"op": "replace", "path": "/tags/4", "value": 1}]`:                                 codes.FailedPrecondition,
+		`[{"op": "move", "from": "/size", "path": "/size/inner"}]`:                           codes.FailedPrecondition,
+		`[{"op": "add", "path": "/name"}]`:                                                   codes.FailedPrecondition,
+		`[{"op": "add", "path": "name", "value": 1}]`:                                        codes.FailedPrecondition,
+		`[{"op": "frobnicate", "path": "/name"}]`:                                            codes.InvalidArgument,
+		`{"op": "remove", "path": "/name"}`:                                                  codes.InvalidArgument,
+	}
+	for p, code := range failing {
+		_, err = client.JSONPatch(
+			ctx,
+			&pb.JSONPatchRequest{Key: "doc", Patch: []byte(p)},
+		)
+		assertErrorCode(t, status.Code(err), code)
+	}
+	kv, err = client.Get(ctx, &pb.Key{Key: "doc"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), expected)
+
+	// Replacing the whole document
+	rv, err = client.JSONPatch(
+		ctx,
+		&pb.JSONPatchRequest{
+			Key:   "doc",
+			Patch: []byte(`[{"op": "replace", "path": "", "value": [1, 2]}]`),
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Version, 3)
+	got, err := client.JSONGet(ctx, &pb.JSONGetRequest{Key: "doc", Path: "$[1]"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(got.Value), "2")
+
+	// Values which aren't JSON strings are rejected
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{Key: "text", Value: []byte("not json")},
+	)
+	fatalOnErr(t, err)
+	_, err = client.JSONGet(ctx, &pb.JSONGetRequest{Key: "text"})
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	_, err = client.JSONPatch(
+		ctx,
+		&pb.JSONPatchRequest{Key: "text", Patch: []byte(`[]`)},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	_, err = client.ListPush(
+		ctx,
+		&pb.ListPushRequest{Key: "list", Values: [][]byte{[]byte("1")}},
+	)
+	fatalOnErr(t, err)
+	_, err = client.JSONGet(ctx, &pb.JSONGetRequest{Key: "list"})
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+
+	_, err = client.JSONPatch(
+		ctx,
+		&pb.JSONPatchRequest{Key: "nope", Patch: []byte(`[]`)},
+	)
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	// The patched value is still limited by max_value_size
+	srv.cfgMu.Lock()
+	srv.cfg.MaxValueSize = 10
+	srv.cfgMu.Unlock()
+	_, err = client.JSONPatch(
+		ctx,
+		&pb.JSONPatchRequest{
+			Key:   "doc",
+			Patch: []byte(`[{"op": "add", "path": "/-", "value": "abcdefgh"}]`),
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.FailedPrecondition)
+	srv.cfgMu.Lock()
+	srv.cfg.MaxValueSize = DefaultMaxValueSize
+	srv.cfgMu.Unlock()
+}
+
+func TestSchemas(t *testing.T) {
+	cfg := NewConfig()
+	cfg.PrivilegedClientID = "admin"
+	cfg.MinLockDuration = time.Second
+	cfg.Schemas = []SchemaConfig{
+		{
+			Prefix: "config/",
+			Schema: `{
+				"type": "object",
+				"required": ["host", "port"],
+				"properties": {
+					"host": {"type": "string", "minLength": 1},
+					"port": {"type": "integer", "minimum": 1, "maximum": 65535},
+					"tags": {"type": "array", "items": {"enum": ["a", "b"]}}
+				},
+				"additionalProperties": false
+			}`,
+		},
+	}
+	badCfg := NewConfig()
+	badCfg.Schemas = []SchemaConfig{{Prefix: "x", Schema: `{"type": 1}`}}
+	if badCfg.Validate() == nil {
+		t.Fatalf("expected invalid schema to fail validation")
+	}
+
+	srv, lis := newServer(t, nil, cfg)
+	client := newClient(t, srv, lis, "client-a")
+	admin := newClient(t, srv, lis, "admin")
+
+	_, err := client.Set(
+		ctx,
+		&pb.KeyValue{
+			Key:   "config/web",
+			Value: []byte(`{"host": "localhost", "port": 8080, "tags": ["a"]}`),
+		},
+	)
+	fatalOnErr(t, err)
+
+	invalid := map[string]string{
+		`{"host": "localhost", "port": "8080"}`:            "$.port: expected integer, got string",
+		`{"host": "localhost", "port": 70000}`:             "$.port: must be <= 65535",
+		`{"host": "localhost"}`:                            "$.port: required",
+		`{"host": "", "port": 80, "extra": 1}`:             "$.extra: not allowed",
+		`{"host": "localhost", "port": 80, "tags": ["c"]}`: "$.tags[0]: must be one of the values in enum",
+		`{"host": "localhost", "port": 80.5}`:              "$.port: expected integer, got number",
+		`[]`:                                               "$: expected object, got array",
+		`host=localhost`:                                   "value is not valid JSON",
+	}
+	for value, expected := range invalid {
+		_, err = client.Set(
+			ctx,
+			&pb.KeyValue{Key: "config/web", Value: []byte(value)},
+		)
+		assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain '%s', got: %s", expected, err)
+		}
+	}
+	kv, err := client.Get(ctx, &pb.Key{Key: "config/web"})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), `{"host": "localhost", "port": 8080, "tags": ["a"]}`)
+
+	// Keys without a bound prefix aren't validated
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "other", Value: []byte("x")})
+	fatalOnErr(t, err)
+
+	// Batch items, transactions, copies and new keys created by Lock
+	// are validated the same way
+	batchResp, err := client.BatchSet(
+		ctx,
+		&pb.BatchSetRequest{
+			Items: []*pb.KeyValue{
+				{Key: "config/a", Value: []byte(`{"host": "a", "port": 1}`)},
+				{Key: "config/b", Value: []byte(`{"host": "b"}`)},
+			},
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, batchResp.Results[0].Error == nil, true)
+	assertEqual(t, batchResp.Results[1].Error.Code, uint32(codes.InvalidArgument))
+
+	_, err = client.Txn(
+		ctx,
+		&pb.TxnRequest{
+			Ops: []*pb.TxnOp{
+				{Op: &pb.TxnOp_Set{Set: &pb.KeyValue{Key: "txn", Value: []byte("1")}}},
+				{Op: &pb.TxnOp_Set{Set: &pb.KeyValue{Key: "config/c", Value: []byte("1")}}},
+			},
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+	_, err = client.Get(ctx, &pb.Key{Key: "txn"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	_, err = client.Copy(
+		ctx,
+		&pb.CopyRequest{Source: "other", Destination: "config/other"},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	_, err = client.Lock(
+		ctx,
+		&pb.LockRequest{
+			Key:             "config/new",
+			CreateIfMissing: true,
+			Duration:        durationpb.New(10 * time.Second),
+		},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	// Schemas are managed by the privileged client, and the longest
+	// matching prefix applies
+	_, err = client.SetSchema(
+		ctx,
+		&pb.SetSchemaRequest{Prefix: "other", Schema: []byte(`true`)},
+	)
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+
+	_, err = admin.SetSchema(
+		ctx,
+		&pb.SetSchemaRequest{Prefix: "config/", Schema: []byte(`{"$ref": "#/$defs/x"}`)},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+	_, err = admin.SetSchema(
+		ctx,
+		&pb.SetSchemaRequest{Prefix: "config/", Schema: []byte(`{"type": "nope"}`)},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	setResp, err := admin.SetSchema(
+		ctx,
+		&pb.SetSchemaRequest{
+			Prefix: "config/flags/",
+			Schema: []byte(`{"oneOf": [{"type": "boolean"}, {"const": "auto"}]}`),
+		},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, setResp.Replaced, false)
+
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{Key: "config/flags/debug", Value: []byte(`"auto"`)},
+	)
+	fatalOnErr(t, err)
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{Key: "config/flags/debug", Value: []byte(`"on"`)},
+	)
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	listResp, err := admin.ListSchemas(ctx, &pb.ListSchemasRequest{})
+	fatalOnErr(t, err)
+	assertEqual(t, len(listResp.Schemas), 2)
+	assertEqual(t, listResp.Schemas[0].Prefix, "config/")
+	assertEqual(t, listResp.Schemas[1].Prefix, "config/flags/")
+
+	// Schemas are bound to prefixes in the request's namespace
+	nsAdmin := newClient(t, srv, lis, "admin", kclient.WithNamespace("ns1"))
+	nsClient := newClient(t, srv, lis, "client-b", kclient.WithNamespace("ns1"))
+	_, err = nsClient.Set(
+		ctx,
+		&pb.KeyValue{Key: "config/web", Value: []byte("x")},
+	)
+	fatalOnErr(t, err)
+	_, err = nsAdmin.SetSchema(
+		ctx,
+		&pb.SetSchemaRequest{Prefix: "", Schema: []byte(`{"type": "string"}`)},
+	)
+	fatalOnErr(t, err)
+	_, err = nsClient.Set(ctx, &pb.KeyValue{Key: "any", Value: []byte("1")})
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+	_, err = nsClient.Set(ctx, &pb.KeyValue{Key: "any", Value: []byte(`"1"`)})
+	fatalOnErr(t, err)
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "any", Value: []byte("1")})
+	fatalOnErr(t, err)
+
+	delResp, err := admin.DeleteSchema(
+		ctx,
+		&pb.DeleteSchemaRequest{Prefix: "config/"},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, delResp.Deleted, true)
+	_, err = client.Set(
+		ctx,
+		&pb.KeyValue{Key: "config/web", Value: []byte("host=localhost")},
+	)
+	fatalOnErr(t, err)
+}
+
+func TestCompression(t *testing.T) {
+	cfg := NewConfig()
+	cfg.RevisionLimit = 5
+	cfg.CompressionThreshold = 64
+
+	srv, lis := newServer(t, nil, cfg)
+	client := newClient(t, srv, lis, "")
+
+	key := "compressed"
+	values := make([][]byte, 0, 3)
+	for i := 1; i <= 3; i++ {
+		value := []byte(strings.Repeat(fmt.Sprintf("value-%d;", i), 200))
+		values = append(values, value)
+		_, err := client.Set(ctx, &pb.KeyValue{Key: key, Value: value})
+		fatalOnErr(t, err)
+	}
+	current := values[len(values)-1]
+
+	srv.mu.RLock()
+	kvInfo := srv.store[key]
+	srv.mu.RUnlock()
+	kvInfo.mu.RLock()
+	compressed := kvInfo.Compressed
+	storedLen := len(kvInfo.Value)
+	kvInfo.mu.RUnlock()
+	assertEqual(t, compressed, true)
+	if storedLen >= len(current) {
+		t.Fatalf("expected stored value to be smaller than %d, got %d", len(current), storedLen)
+	}
+
+	kv, err := client.Get(ctx, &pb.Key{Key: key})
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, kv.Value, current)
+
+	inspected, err := client.Inspect(
+		ctx,
+		&pb.InspectRequest{Key: key, IncludeValue: true},
+	)
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, inspected.Value, current)
+	assertEqual(t, inspected.Size, uint64(len(current)))
+
+	for i, value := range values[:len(values)-1] {
+		rev, err := client.GetRevision(
+			ctx,
+			&pb.GetRevisionRequest{Key: key, Version: int64(i + 1)},
+		)
+		fatalOnErr(t, err)
+		assertSlicesEqual(t, rev.Value, value)
+	}
+
+	// Small values are stored as-is
+	_, err = client.Set(ctx, &pb.KeyValue{Key: "small", Value: []byte("bar")})
+	fatalOnErr(t, err)
+	srv.mu.RLock()
+	smallInfo := srv.store["small"]
+	srv.mu.RUnlock()
+	smallInfo.mu.RLock()
+	assertEqual(t, smallInfo.Compressed, false)
+	smallInfo.mu.RUnlock()
+
+	stats, err := client.Stats(ctx, &pb.EmptyRequest{})
+	fatalOnErr(t, err)
+	if stats.GetStoredSize() >= stats.GetTotalSize() {
+		t.Fatalf(
+			"expected stored size (%d) to be less than total size (%d)",
+			stats.GetStoredSize(),
+			stats.GetTotalSize(),
+		)
+	}
+	if stats.History.GetStoredSize() >= stats.History.GetSize() {
+		t.Fatalf(
+			"expected history stored size (%d) to be less than size (%d)",
+			stats.History.GetStoredSize(),
+			stats.History.GetSize(),
+		)
+	}
+
+	srv.mu.RLock()
+	data, err := json.Marshal(srv)
+	srv.mu.RUnlock()
+	fatalOnErr(t, err)
+
+	newStore, err := New(cfg)
+	fatalOnErr(t, err)
+	newStore.mu.Lock()
+	err = json.Unmarshal(data, newStore)
+	newStore.mu.Unlock()
+	fatalOnErr(t, err)
+
+	newStore.mu.RLock()
+	loaded := newStore.store[key]
+	newStore.mu.RUnlock()
+	assertNotNil(t, loaded)
+	loaded.mu.RLock()
+	defer loaded.mu.RUnlock()
+	assertEqual(t, loaded.Compressed, true)
+	assertEqual(t, loaded.Size, uint64(len(current)))
+	assertEqual(t, loaded.Hash, kvInfo.Hash)
+	loadedValue, err := loaded.rawValue(newStore.keys)
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, loadedValue, current)
+}
+
+func TestEncryption(t *testing.T) {
+	firstKey := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	secondKey := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32))
+
+	cfg := NewConfig()
+	cfg.RevisionLimit = 3
+	cfg.PersistentRevisions = true
+	cfg.Encryption = EncryptionConfig{
+		KeyID:  "first",
+		Key:    firstKey,
+		Values: true,
+	}
+	srv, lis := newServer(t, nil, cfg)
+	client := newClient(t, srv, lis, "")
+
+	key := "secret"
+	values := [][]byte{[]byte("hunter2-hunter2"), []byte("correct horse battery staple")}
+	for _, value := range values {
+		_, err := client.Set(ctx, &pb.KeyValue{Key: key, Value: value})
+		fatalOnErr(t, err)
+	}
+	current := values[len(values)-1]
+
+	srv.mu.RLock()
+	kvInfo := srv.store[key]
+	srv.mu.RUnlock()
+	kvInfo.mu.RLock()
+	assertEqual(t, kvInfo.Encrypted, true)
+	if bytes.Contains(kvInfo.Value, current) {
+		t.Fatalf("expected value to be encrypted in memory")
+	}
+	kvInfo.mu.RUnlock()
+
+	kv, err := client.Get(ctx, &pb.Key{Key: key})
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, kv.Value, current)
+
+	rev, err := client.GetRevision(
+		ctx,
+		&pb.GetRevisionRequest{Key: key, Version: 1},
+	)
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, rev.Value, values[0])
+
+	// List elements and hash field values are encrypted individually
+	_, err = client.ListPush(
+		ctx,
+		&pb.ListPushRequest{
+			Key:    "list",
+			Values: [][]byte{[]byte("hunter2-a"), []byte("hunter2-b")},
+			End:    pb.ListEnd_RIGHT,
+		},
+	)
+	fatalOnErr(t, err)
+	_, err = client.HSet(
+		ctx,
+		&pb.HSetRequest{
+			Key:    "hash",
+			Fields: map[string][]byte{"pw": []byte("hunter2-c")},
+		},
+	)
+	fatalOnErr(t, err)
+
+	srv.mu.RLock()
+	listInfo := srv.store["list"]
+	hashInfo := srv.store["hash"]
+	srv.mu.RUnlock()
+	listInfo.mu.RLock()
+	assertEqual(t, listInfo.Encrypted, true)
+	assertEqual(t, listInfo.Size, uint64(len("hunter2-a")+len("hunter2-b")))
+	for _, v := range listInfo.List {
+		if bytes.Contains(v, []byte("hunter2")) {
+			t.Fatalf("expected list element to be encrypted in memory")
+		}
+	}
+	listInfo.mu.RUnlock()
+	hashInfo.mu.RLock()
+	if bytes.Contains(hashInfo.Fields["pw"], []byte("hunter2")) {
+		t.Fatalf("expected hash field to be encrypted in memory")
+	}
+	hashInfo.mu.RUnlock()
+
+	listRange, err := client.ListRange(
+		ctx,
+		&pb.ListRangeRequest{Key: "list", Start: 0, Stop: -1},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, len(listRange.Values), 2)
+	assertSlicesEqual(t, listRange.Values[1], []byte("hunter2-b"))
+	field, err := client.HGet(ctx, &pb.HGetRequest{Key: "hash", Field: "pw"})
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, field.Value, []byte("hunter2-c"))
+	fields, err := client.HGetAll(ctx, &pb.Key{Key: "hash"})
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, fields.Fields["pw"], []byte("hunter2-c"))
+
+	srv.mu.RLock()
+	srv.hmu.RLock()
+	data, err := json.Marshal(srv)
+	srv.hmu.RUnlock()
+	srv.mu.RUnlock()
+	fatalOnErr(t, err)
+	encrypted, err := srv.keys.encryptSnapshot(data)
+	fatalOnErr(t, err)
+
+	var header encryptedSnapshot
+	fatalOnErr(t, json.Unmarshal(encrypted, &header))
+	assertEqual(t, header.Encryption, snapshotEncryption)
+	assertEqual(t, header.KeyID, "first")
+
+	// After rotating to a new key, snapshots encrypted with the
+	// previous key can still be loaded
+	keyFile := filepath.Join(t.TempDir(), "key")
+	fatalOnErr(t, os.WriteFile(keyFile, []byte(secondKey+"\n"), 0600))
+	rotatedCfg := NewConfig()
+	rotatedCfg.RevisionLimit = 3
+	rotatedCfg.Encryption = EncryptionConfig{
+		KeyID:        "second",
+		KeyFile:      keyFile,
+		PreviousKeys: map[string]string{"first": firstKey},
+	}
+	rotated, err := NewServerFromSnapshot(encrypted, rotatedCfg)
+	fatalOnErr(t, err)
+
+	rotated.mu.RLock()
+	loaded := rotated.store[key]
+	rotated.mu.RUnlock()
+	assertNotNil(t, loaded)
+	loaded.mu.RLock()
+	assertEqual(t, loaded.Encrypted, false)
+	assertSlicesEqual(t, loaded.Value, current)
+	loaded.mu.RUnlock()
+
+	rotated.hmu.RLock()
+	revisions := rotated.history[key]
+	rotated.hmu.RUnlock()
+	assertEqual(t, revisions[0].Version, uint64(1))
+	revValue, err := revisions[0].rawValue(rotated.keys)
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, revValue, values[0])
+
+	rotated.mu.RLock()
+	loadedList := rotated.store["list"]
+	rotated.mu.RUnlock()
+	loadedList.mu.RLock()
+	assertEqual(t, loadedList.Encrypted, false)
+	assertEqual(t, loadedList.Size, uint64(len("hunter2-a")+len("hunter2-b")))
+	assertSlicesEqual(t, loadedList.List[0], []byte("hunter2-a"))
+	loadedList.mu.RUnlock()
+
+	// Unencrypted snapshots can still be loaded
+	plainCfg := NewConfig()
+	plainCfg.Encryption = EncryptionConfig{KeyID: "second", Key: secondKey}
+	_, err = NewServerFromSnapshot([]byte(`{"keys":[]}`), plainCfg)
+	fatalOnErr(t, err)
+
+	// Without the previous key, the snapshot can't be decrypted
+	missingCfg := NewConfig()
+	missingCfg.Encryption = EncryptionConfig{KeyID: "second", Key: secondKey}
+	_, err = NewServerFromSnapshot(encrypted, missingCfg)
+	if err == nil {
+		t.Fatalf("expected error decrypting snapshot without its key")
+	}
+
+	_, err = NewServerFromSnapshot(encrypted, NewConfig())
+	if err == nil {
+		t.Fatalf("expected error loading encrypted snapshot without a key")
+	}
+
+	// ...and neither can revisions encrypted with it
+	var state map[string]json.RawMessage
+	fatalOnErr(t, json.Unmarshal(data, &state))
+	state["keys"] = json.RawMessage("[]")
+	historyOnly, err := json.Marshal(state)
+	fatalOnErr(t, err)
+	_, err = NewServerFromSnapshot(historyOnly, missingCfg)
+	if err == nil {
+		t.Fatalf("expected error loading revisions without their key")
+	}
+
+	// Unencrypted list elements are encrypted when they're loaded
+	typedCfg := NewConfig()
+	typedCfg.Encryption = EncryptionConfig{Key: secondKey, Values: true}
+	typed, err := NewServerFromSnapshot(
+		[]byte(`{"keys":[{"key":"list","type":1,"list":["YQ=="]}]}`),
+		typedCfg,
+	)
+	fatalOnErr(t, err)
+	typed.mu.RLock()
+	typedList := typed.store["list"]
+	typed.mu.RUnlock()
+	typedList.mu.RLock()
+	assertEqual(t, typedList.Encrypted, true)
+	assertEqual(t, typedList.Size, 1)
+	element, err := typedList.rawElement(typedList.List[0], typed.keys)
+	typedList.mu.RUnlock()
+	fatalOnErr(t, err)
+	assertSlicesEqual(t, element, []byte("a"))
+
+	badCfg := NewConfig()
+	badCfg.Encryption = EncryptionConfig{Key: base64.StdEncoding.EncodeToString([]byte("short"))}
+	if _, err = New(badCfg); err == nil {
+		t.Fatalf("expected error with an invalid key")
+	}
+
+	valuesCfg := NewConfig()
+	valuesCfg.Encryption = EncryptionConfig{Values: true}
+	if _, err = New(valuesCfg); err == nil {
+		t.Fatalf("expected error encrypting values without a key")
+	}
+}
+
+func TestRevisions(t *testing.T) {
+	cfg := NewConfig()
+	cfg.RevisionLimit = 5
+	srv, lis := newServer(t, nil, cfg)
+	client := newClient(t, srv, lis, "")
+
+	key := "foo"
+	values := []string{"bar", `{"baz": 1}`, "qux"}
+	for _, value := range values {
+		_, err := client.Set(ctx, &pb.KeyValue{Key: key, Value: []byte(value)})
+		fatalOnErr(t, err)
+	}
+
+	rv, err := client.ListRevisions(ctx, &pb.Key{Key: key})
+	fatalOnErr(t, err)
+	assertEqual(t, len(rv.Revisions), len(values))
+	for i, rev := range rv.Revisions {
+		assertEqual(t, rev.Version, uint64(i+1))
+		assertEqual(t, rev.Size, uint64(len(values[i])))
+		assertNotNil(t, rev.Timestamp)
+	}
+	assertEqual(t, rv.Revisions[0].ContentType, "text/plain; charset=utf-8")
+
+	inspected, err := client.Inspect(ctx, &pb.InspectRequest{Key: key})
+	fatalOnErr(t, err)
+	assertEqual(t, rv.Revisions[2].Hash, inspected.Hash)
+
+	_, err = client.ListRevisions(ctx, &pb.Key{Key: "missing"})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	rollback, err := client.Rollback(
+		ctx,
+		&pb.RollbackRequest{Key: key, Version: 1},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rollback.Version, uint64(4))
+	assertEqual(t, rollback.Hash, rv.Revisions[0].Hash)
+
+	kv, err := client.Get(ctx, &pb.Key{Key: key})
+	fatalOnErr(t, err)
+	assertEqual(t, string(kv.Value), values[0])
+
+	rv, err = client.ListRevisions(ctx, &pb.Key{Key: key})
+	fatalOnErr(t, err)
+	assertEqual(t, len(rv.Revisions), 4)
+	assertEqual(t, rv.Revisions[3].Version, uint64(4))
+
+	// Rolling back to the current value doesn't add a version
+	rollback, err = client.Rollback(
+		ctx,
+		&pb.RollbackRequest{Key: key, Version: 4},
+	)
+	fatalOnErr(t, err)
+	assertEqual(t, rollback.Version, uint64(4))
+
+	_, err = client.Rollback(ctx, &pb.RollbackRequest{Key: key, Version: 0})
+	assertErrorCode(t, status.Code(err), codes.InvalidArgument)
+
+	_, err = client.Rollback(ctx, &pb.RollbackRequest{Key: key, Version: 10})
+	assertErrorCode(t, status.Code(err), codes.NotFound)
+
+	// Rollbacks are subject to the same locks as Set
+	otherClient := newClient(t, srv, lis, "other")
+	_, err = otherClient.Lock(
+		ctx,
+		&pb.LockRequest{Key: key, Duration: durationpb.New(time.Minute)},
+	)
+	fatalOnErr(t, err)
+	_, err = client.Rollback(ctx, &pb.RollbackRequest{Key: key, Version: 2})
+	assertErrorCode(t, status.Code(err), codes.PermissionDenied)
+}
@@ -0,0 +1,276 @@
+package server
+
+import (
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"log/slog"
+	"slices"
+	"time"
+)
+
+var (
+	ErrNoMembers = KQError{
+		Message: "at least one member is required",
+		Code:    codes.InvalidArgument,
+	}
+	ErrEmptyMember = KQError{
+		Message: "member must not be empty",
+		Code:    codes.InvalidArgument,
+	}
+)
+
+// validateMembers returns ErrNoMembers if no members were provided, or
+// ErrEmptyMember if any of them are empty
+func validateMembers(members []string) error {
+	if len(members) == 0 {
+		return ErrNoMembers
+	}
+	for _, member := range members {
+		if member == "" {
+			return ErrEmptyMember
+		}
+	}
+	return nil
+}
+
+// SAdd adds members to a set, creating the key if it doesn't exist.
+// Members already in the set are ignored. The total size of the members
+// of the set is limited by [Config.MaxValueSize].
+func (s *Server) SAdd(ctx context.Context, in *pb.SetMembersRequest) (
+	*pb.SAddResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request to add set members",
+		slog.String("key", in.Key),
+		slog.Int("members", len(in.Members)),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if _, _, err := validateSetRequest(cfg, &pb.KeyValue{Key: in.Key}); err != nil {
+		return nil, err
+	}
+	if err := validateMembers(in.Members); err != nil {
+		return nil, err
+	}
+	if cfg.MaxValueSize > 0 && setSize(in.Members) > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kvInfo, isNew, err := s.typedKeyForWrite(
+		cfg,
+		clientID,
+		key,
+		pb.ValueType_SET,
+		true,
+	)
+	if err != nil {
+		logger.Info("unable to add set members", "error", err)
+		return nil, err
+	}
+
+	kvInfo.mu.Lock()
+	defer kvInfo.mu.Unlock()
+
+	size := kvInfo.Size
+	added := make(map[string]struct{}, len(in.Members))
+	for _, member := range in.Members {
+		if _, ok := kvInfo.Members[member]; ok {
+			continue
+		}
+		if _, ok := added[member]; ok {
+			continue
+		}
+		added[member] = struct{}{}
+		size += uint64(len(member))
+	}
+	if cfg.MaxValueSize > 0 && size > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+
+	if len(added) > 0 {
+		if kvInfo.Members == nil {
+			kvInfo.Members = make(map[string]struct{}, len(added))
+		}
+		for member := range added {
+			kvInfo.Members[member] = struct{}{}
+		}
+		s.updateValue(kvInfo, clientID, size, isNew)
+	}
+
+	return &pb.SAddResponse{
+		Added:   uint64(len(added)),
+		Version: kvInfo.Version,
+	}, nil
+}
+
+// SRem removes members from a set. Members which aren't in the set are
+// ignored. If none of the members were in the set, the key's version is
+// left unchanged.
+func (s *Server) SRem(ctx context.Context, in *pb.SetMembersRequest) (
+	*pb.SRemResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request to remove set members",
+		slog.String("key", in.Key),
+		slog.Int("members", len(in.Members)),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if _, _, err := validateSetRequest(cfg, &pb.KeyValue{Key: in.Key}); err != nil {
+		return nil, err
+	}
+	if len(in.Members) == 0 {
+		return nil, ErrNoMembers
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kvInfo, _, err := s.typedKeyForWrite(
+		cfg,
+		clientID,
+		key,
+		pb.ValueType_SET,
+		false,
+	)
+	if err != nil {
+		logger.Info("unable to remove set members", "error", err)
+		return nil, err
+	}
+
+	kvInfo.mu.Lock()
+	defer kvInfo.mu.Unlock()
+
+	size := kvInfo.Size
+	var removed uint64
+	for _, member := range in.Members {
+		if _, ok := kvInfo.Members[member]; !ok {
+			continue
+		}
+		delete(kvInfo.Members, member)
+		size -= uint64(len(member))
+		removed++
+	}
+	if removed > 0 {
+		s.updateValue(kvInfo, clientID, size, false)
+	}
+
+	return &pb.SRemResponse{Removed: removed, Version: kvInfo.Version}, nil
+}
+
+// SMembers returns the members of a set, sorted
+func (s *Server) SMembers(ctx context.Context, in *pb.Key) (
+	*pb.SMembersResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info("request for set members", slog.String("key", in.Key))
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	kvInfo, err := s.typedKeyForRead(clientID, key, pb.ValueType_SET)
+	if err != nil {
+		return nil, err
+	}
+
+	kvInfo.mu.RLock()
+	members := make([]string, 0, len(kvInfo.Members))
+	for member := range kvInfo.Members {
+		members = append(members, member)
+	}
+	kvInfo.mu.RUnlock()
+	slices.Sort(members)
+
+	t := time.Now()
+	s.emit(key, Accessed, clientID, &t)
+	return &pb.SMembersResponse{Members: members}, nil
+}
+
+// SIsMember returns whether a value is a member of a set
+func (s *Server) SIsMember(ctx context.Context, in *pb.SIsMemberRequest) (
+	*pb.SIsMemberResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Debug(
+		"request to check set membership",
+		slog.String("key", in.Key),
+		slog.String("member", in.Member),
+	)
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	kvInfo, err := s.typedKeyForRead(clientID, key, pb.ValueType_SET)
+	if err != nil {
+		return nil, err
+	}
+
+	kvInfo.mu.RLock()
+	_, isMember := kvInfo.Members[in.Member]
+	kvInfo.mu.RUnlock()
+
+	t := time.Now()
+	s.emit(key, Accessed, clientID, &t)
+	return &pb.SIsMemberResponse{IsMember: isMember}, nil
+}
+
+// setSize returns the total size of the given members, counting
+// duplicates once
+func setSize(members []string) uint64 {
+	seen := make(map[string]struct{}, len(members))
+	var size uint64
+	for _, member := range members {
+		if _, ok := seen[member]; ok {
+			continue
+		}
+		seen[member] = struct{}{}
+		size += uint64(len(member))
+	}
+	return size
+}
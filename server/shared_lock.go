@@ -0,0 +1,183 @@
+package server
+
+import (
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+// newSharedKeyLock creates a new shared lock on a key for the given
+// client, and starts its timer. Server.lockMu must be held by the caller.
+func newSharedKeyLock(
+	srv *Server,
+	d time.Duration,
+	key string,
+	clientID string,
+) *kvLock {
+	now := time.Now()
+	k := &kvLock{
+		Key:      key,
+		ClientID: clientID,
+		Duration: d,
+		srv:      srv,
+		Created:  now,
+		ID:       uuid.NewString(),
+		Shared:   true,
+		expires:  now.Add(d),
+	}
+	k.FencingToken = srv.issueFencingToken(key)
+	k.t = time.AfterFunc(d, k.UnlockFunc())
+	srv.addSharedLock(k)
+	srv.numLocks.Add(1)
+	srv.emit(key, Locked, clientID, &now)
+	return k
+}
+
+// lockShared grants (or renews) a shared lock on the given key for the
+// given client, returning the lock. If the client holds the exclusive
+// lock on the key, it's converted to a shared lock. Server.lockMu must
+// be held by the caller.
+func (s *Server) lockShared(
+	logger *slog.Logger,
+	d time.Duration,
+	key string,
+	clientID string,
+) *kvLock {
+	if keyLock, ok := s.sharedLocks[key][clientID]; ok {
+		logger.Debug("renewing shared lock", slog.String("key", key))
+		_ = keyLock.renew(d, clientID)
+		return keyLock
+	}
+
+	// checkLock only allows this if it's the client's own lock
+	if keyLock, ok := s.locks[key]; ok {
+		logger.Debug("converting exclusive lock to shared", "key_lock", keyLock)
+		_ = keyLock.t.Stop()
+		s.unbindLease(keyLock.LeaseID, key, true)
+		delete(s.locks, key)
+		s.numLocks.Add(decrementUint64)
+	}
+
+	newLock := newSharedKeyLock(s, d, key, clientID)
+	logger.Info("shared lock granted", "key_lock", newLock)
+	// Other shared lock requests may now be granted
+	s.notifyLockWaiter(key)
+	return newLock
+}
+
+// unlockShared releases the given client's shared lock on a key, when
+// its timer fires, if it's still the lock with the given ID. Server.mu
+// and Server.lockMu must be held by the caller.
+func (s *Server) unlockShared(key string, clientID string, lockID string) {
+	keyLock, ok := s.sharedLocks[key][clientID]
+	if !ok || keyLock.ID != lockID {
+		return
+	}
+	logger := s.logger.With(loggerKey, "lock", "key_lock", keyLock)
+	logger.Info("releasing shared lock on trigger")
+
+	s.removeSharedLock(key, clientID)
+	now := time.Now()
+	s.emit(key, Unlocked, InternalClientID, &now)
+}
+
+// addSharedLock adds the given shared lock to Server.sharedLocks.
+// Server.lockMu must be held by the caller.
+func (s *Server) addSharedLock(k *kvLock) {
+	holders, ok := s.sharedLocks[k.Key]
+	if !ok {
+		holders = make(map[string]*kvLock)
+		s.sharedLocks[k.Key] = holders
+	}
+	holders[k.ClientID] = k
+}
+
+// removeSharedLock stops the timer for, and removes, the given client's
+// shared lock on a key. Returns false if the client doesn't hold a
+// shared lock on the key. Server.lockMu must be held by the caller.
+func (s *Server) removeSharedLock(key string, clientID string) bool {
+	holders := s.sharedLocks[key]
+	keyLock, ok := holders[clientID]
+	if !ok {
+		return false
+	}
+	if keyLock.t != nil {
+		_ = keyLock.t.Stop()
+	}
+	s.unbindLease(keyLock.LeaseID, key, true)
+	delete(holders, clientID)
+	if len(holders) == 0 {
+		delete(s.sharedLocks, key)
+	}
+	s.numLocks.Add(decrementUint64)
+	s.notifyLockWaiter(key)
+	return true
+}
+
+// removeSharedLocks removes every shared lock on a key, returning the
+// number of locks removed. Server.lockMu must be held by the caller.
+func (s *Server) removeSharedLocks(key string) int {
+	holders := s.sharedLocks[key]
+	ct := len(holders)
+	for clientID := range holders {
+		s.removeSharedLock(key, clientID)
+	}
+	return ct
+}
+
+// sharedLockedByOthers returns true if a client other than the given
+// client holds a shared lock on a key. Server.lockMu must be held by
+// the caller.
+func (s *Server) sharedLockedByOthers(key string, clientID string) bool {
+	for holder := range s.sharedLocks[key] {
+		if holder != clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// lockHolders returns the clients currently holding a lock on a key,
+// sorted by client ID. Server.lockMu must be held by the caller.
+func (s *Server) lockHolders(key string) []*pb.LockHolder {
+	if keyLock, ok := s.locks[key]; ok {
+		return []*pb.LockHolder{keyLock.holder()}
+	}
+	holders := make([]*pb.LockHolder, 0, len(s.sharedLocks[key]))
+	for _, keyLock := range s.sharedLocks[key] {
+		holders = append(holders, keyLock.holder())
+	}
+	sort.Slice(
+		holders, func(i, j int) bool {
+			return holders[i].ClientId < holders[j].ClientId
+		},
+	)
+	return holders
+}
+
+// keyLocked returns true if any client holds an exclusive or shared lock
+// on a key, or a permit for its semaphore. Server.lockMu must be held by
+// the caller.
+func (s *Server) keyLocked(key string) bool {
+	if len(s.lockHolders(key)) > 0 {
+		return true
+	}
+	sem, ok := s.semaphores[key]
+	return ok && len(sem.Permits) > 0
+}
+
+// holder returns the [pb.LockHolder] describing this lock
+func (k *kvLock) holder() *pb.LockHolder {
+	h := &pb.LockHolder{
+		ClientId: k.ClientID,
+		Mode:     pb.LockMode_EXCLUSIVE,
+		Expires:  timestamppb.New(k.expires),
+	}
+	if k.Shared {
+		h.Mode = pb.LockMode_SHARED
+	}
+	return h
+}
@@ -53,6 +53,12 @@ func (s *snapshotter) snapshot(ctx context.Context) (rowID int64, err error) {
 	if err != nil {
 		return rowID, fmt.Errorf("unable to marshal snapshot: %w", err)
 	}
+	if s.server.keys != nil {
+		snapshotData, err = s.server.keys.encryptSnapshot(snapshotData)
+		if err != nil {
+			return rowID, fmt.Errorf("unable to encrypt snapshot: %w", err)
+		}
+	}
 
 	tx, e := s.db.Begin()
 	if e != nil {
@@ -122,7 +128,7 @@ func (s *snapshotter) Run(ctx context.Context, events <-chan Event) error {
 		for ev := range events {
 			s.logger.Debug("snapshotter saw event", "event", ev)
 
-			if strings.HasPrefix(ev.Key, ReservedKeyPrefix) {
+			if strings.HasPrefix(keyName(ev.Key), ReservedKeyPrefix) {
 				continue
 			}
 			switch ev.Event {
@@ -321,6 +327,10 @@ func NewServerFromSnapshot(data []byte, cfg *Config) (*Server, error) {
 		return nil, err
 	}
 
+	data, err = srv.keys.decryptSnapshot(data)
+	if err != nil {
+		return nil, err
+	}
 	if err = json.Unmarshal(data, srv); err != nil {
 		return nil, fmt.Errorf("unable to unmarshal snapshot: %w", err)
 	}
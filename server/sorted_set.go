@@ -0,0 +1,332 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	pb "github.com/arcward/keyquarry/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"log/slog"
+	"math"
+	"slices"
+	"time"
+)
+
+// scoreSize is the size counted for the score of each member of a
+// sorted set
+const scoreSize = 8
+
+var ErrInvalidScore = KQError{
+	Message: "score must be a finite number",
+	Code:    codes.InvalidArgument,
+}
+
+// zMemberSize returns the size of a member of a sorted set, including
+// its score
+func zMemberSize(member string) uint64 {
+	return uint64(len(member) + scoreSize)
+}
+
+// sortedMembers returns the members of a sorted set with scores between
+// minScore and maxScore (inclusive), ordered by score, then by member
+func sortedMembers(
+	scores map[string]float64,
+	minScore float64,
+	maxScore float64,
+) []*pb.ZMember {
+	members := make([]*pb.ZMember, 0, len(scores))
+	for member, score := range scores {
+		if score < minScore || score > maxScore {
+			continue
+		}
+		members = append(members, &pb.ZMember{Member: member, Score: score})
+	}
+	slices.SortFunc(
+		members, func(a, b *pb.ZMember) int {
+			if c := cmp.Compare(a.Score, b.Score); c != 0 {
+				return c
+			}
+			return cmp.Compare(a.Member, b.Member)
+		},
+	)
+	return members
+}
+
+// ZAdd adds members to a sorted set, creating the key if it doesn't
+// exist. The scores of members already in the set are updated. The
+// total size of the members of the set is limited by
+// [Config.MaxValueSize], with each score counted as 8 bytes.
+func (s *Server) ZAdd(ctx context.Context, in *pb.ZAddRequest) (
+	*pb.ZAddResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request to add sorted set members",
+		slog.String("key", in.Key),
+		slog.Int("members", len(in.Members)),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if _, _, err := validateSetRequest(cfg, &pb.KeyValue{Key: in.Key}); err != nil {
+		return nil, err
+	}
+	if len(in.Members) == 0 {
+		return nil, ErrNoMembers
+	}
+	seen := make(map[string]struct{}, len(in.Members))
+	var total uint64
+	for _, m := range in.Members {
+		switch {
+		case m.Member == "":
+			return nil, ErrEmptyMember
+		case math.IsNaN(m.Score) || math.IsInf(m.Score, 0):
+			return nil, ErrInvalidScore
+		}
+		if _, ok := seen[m.Member]; ok {
+			continue
+		}
+		seen[m.Member] = struct{}{}
+		total += zMemberSize(m.Member)
+	}
+	if cfg.MaxValueSize > 0 && total > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kvInfo, isNew, err := s.typedKeyForWrite(
+		cfg,
+		clientID,
+		key,
+		pb.ValueType_ZSET,
+		true,
+	)
+	if err != nil {
+		logger.Info("unable to add sorted set members", "error", err)
+		return nil, err
+	}
+
+	kvInfo.mu.Lock()
+	defer kvInfo.mu.Unlock()
+
+	size := kvInfo.Size
+	scores := make(map[string]float64, len(in.Members))
+	var added uint64
+	for _, m := range in.Members {
+		_, exists := kvInfo.Scores[m.Member]
+		_, seen := scores[m.Member]
+		if !exists && !seen {
+			size += zMemberSize(m.Member)
+			added++
+		}
+		scores[m.Member] = m.Score
+	}
+	if cfg.MaxValueSize > 0 && size > cfg.MaxValueSize {
+		return nil, ErrValueTooLarge
+	}
+
+	if kvInfo.Scores == nil {
+		kvInfo.Scores = make(map[string]float64, len(scores))
+	}
+	for member, score := range scores {
+		kvInfo.Scores[member] = score
+	}
+	s.updateValue(kvInfo, clientID, size, isNew)
+
+	return &pb.ZAddResponse{Added: added, Version: kvInfo.Version}, nil
+}
+
+// ZRangeByScore returns the members of a sorted set with scores between
+// the requested minimum and maximum (inclusive), ordered by score
+func (s *Server) ZRangeByScore(
+	ctx context.Context,
+	in *pb.ZRangeByScoreRequest,
+) (*pb.ZMembersResponse, error) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request for sorted set range",
+		slog.String("key", in.Key),
+		slog.Float64("min", in.Min),
+		slog.Float64("max", in.Max),
+		slog.Uint64("limit", in.Limit),
+	)
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	kvInfo, err := s.typedKeyForRead(clientID, key, pb.ValueType_ZSET)
+	if err != nil {
+		return nil, err
+	}
+
+	kvInfo.mu.RLock()
+	members := sortedMembers(kvInfo.Scores, in.Min, in.Max)
+	kvInfo.mu.RUnlock()
+	if in.Limit > 0 && uint64(len(members)) > in.Limit {
+		members = members[:in.Limit]
+	}
+
+	t := time.Now()
+	s.emit(key, Accessed, clientID, &t)
+	return &pb.ZMembersResponse{Members: members}, nil
+}
+
+// ZRem removes members from a sorted set. Members which aren't in the
+// set are ignored. If none of the members were in the set, the key's
+// version is left unchanged.
+func (s *Server) ZRem(ctx context.Context, in *pb.SetMembersRequest) (
+	*pb.ZRemResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request to remove sorted set members",
+		slog.String("key", in.Key),
+		slog.Int("members", len(in.Members)),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if _, _, err := validateSetRequest(cfg, &pb.KeyValue{Key: in.Key}); err != nil {
+		return nil, err
+	}
+	if len(in.Members) == 0 {
+		return nil, ErrNoMembers
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kvInfo, _, err := s.typedKeyForWrite(
+		cfg,
+		clientID,
+		key,
+		pb.ValueType_ZSET,
+		false,
+	)
+	if err != nil {
+		logger.Info("unable to remove sorted set members", "error", err)
+		return nil, err
+	}
+
+	kvInfo.mu.Lock()
+	defer kvInfo.mu.Unlock()
+
+	size := kvInfo.Size
+	var removed uint64
+	for _, member := range in.Members {
+		if _, ok := kvInfo.Scores[member]; !ok {
+			continue
+		}
+		delete(kvInfo.Scores, member)
+		size -= zMemberSize(member)
+		removed++
+	}
+	if removed > 0 {
+		s.updateValue(kvInfo, clientID, size, false)
+	}
+
+	return &pb.ZRemResponse{Removed: removed, Version: kvInfo.Version}, nil
+}
+
+// ZPopMin removes and returns the requested number of members of a
+// sorted set with the lowest scores (or all of them, if there are
+// fewer). If the set is empty, no members are returned, and the key's
+// version is left unchanged.
+func (s *Server) ZPopMin(ctx context.Context, in *pb.ZPopMinRequest) (
+	*pb.ZMembersResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("key", in.Key))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	count := in.Count
+	if count == 0 {
+		count = 1
+	}
+	logger.Info(
+		"request to pop from sorted set",
+		slog.String("key", in.Key),
+		slog.Uint64("count", count),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+	if _, _, err := validateSetRequest(cfg, &pb.KeyValue{Key: in.Key}); err != nil {
+		return nil, err
+	}
+	key := s.namespacedKey(ctx, in.Key)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	kvInfo, _, err := s.typedKeyForWrite(
+		cfg,
+		clientID,
+		key,
+		pb.ValueType_ZSET,
+		false,
+	)
+	if err != nil {
+		logger.Info("unable to pop from sorted set", "error", err)
+		return nil, err
+	}
+
+	kvInfo.mu.Lock()
+	defer kvInfo.mu.Unlock()
+
+	members := sortedMembers(kvInfo.Scores, math.Inf(-1), math.Inf(1))
+	if uint64(len(members)) > count {
+		members = members[:count]
+	}
+	if len(members) == 0 {
+		return &pb.ZMembersResponse{}, nil
+	}
+
+	size := kvInfo.Size
+	for _, m := range members {
+		delete(kvInfo.Scores, m.Member)
+		size -= zMemberSize(m.Member)
+	}
+	s.updateValue(kvInfo, clientID, size, false)
+	return &pb.ZMembersResponse{Members: members}, nil
+}
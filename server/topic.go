@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
+	"path"
+	"time"
+)
+
+var (
+	ErrEmptyTopic = KQError{
+		Message: "topic must not be empty",
+		Code:    codes.InvalidArgument,
+	}
+	ErrTopicTooLong = KQError{
+		Message: "topic is longer than the maximum key length",
+		Code:    codes.InvalidArgument,
+	}
+	ErrInvalidTopicPattern = KQError{
+		Message: "invalid topic pattern",
+		Code:    codes.InvalidArgument,
+	}
+)
+
+// Publish sends a message to the subscribers of a topic, via
+// [Server.SubscribeTopic]. Messages aren't stored, so clients which
+// aren't subscribed when a message is published never receive it. Topic
+// names are limited by [Config.MaxKeyLength], and payloads by
+// [Config.MaxValueSize].
+func (s *Server) Publish(ctx context.Context, in *pb.PublishRequest) (
+	*pb.PublishResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.String("topic", in.Topic))
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"request to publish message",
+		slog.String("topic", in.Topic),
+		slog.Int("payload_size", len(in.Payload)),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	cfg = cfg.forNamespace(s.Namespace(ctx))
+
+	switch {
+	case in.Topic == "":
+		return nil, ErrEmptyTopic
+	case cfg.MaxKeyLength > 0 && uint64(len(in.Topic)) > cfg.MaxKeyLength:
+		return nil, ErrTopicTooLong
+	case cfg.MaxValueSize > 0 && uint64(len(in.Payload)) > cfg.MaxValueSize:
+		return nil, ErrValueTooLarge
+	}
+
+	payload := make([]byte, len(in.Payload))
+	copy(payload, in.Payload)
+	s.sendEvent(
+		Event{
+			Topic:    s.namespacedKey(ctx, in.Topic),
+			Payload:  payload,
+			Time:     time.Now(),
+			ClientID: clientID,
+		},
+	)
+	return &pb.PublishResponse{}, nil
+}
+
+// SubscribeTopic streams messages published to topics matching any of
+// the requested glob patterns (see [path.Match]), in the client's
+// namespace. If no patterns are provided, messages for every topic are
+// sent.
+func (s *Server) SubscribeTopic(
+	in *pb.SubscribeTopicRequest,
+	stream pb.KeyQuarry_SubscribeTopicServer,
+) error {
+	ctx := stream.Context()
+	clientID, err := s.ClientIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	logger := s.requestLogger(ctx)
+	logger.Info("topic subscription request", "patterns", in.Patterns)
+
+	for _, pattern := range in.Patterns {
+		if _, err = path.Match(pattern, ""); err != nil {
+			return ErrInvalidTopicPattern
+		}
+	}
+	namespace := s.Namespace(ctx)
+
+	sctx, cancel := context.WithCancel(ctx)
+	streamClientID := fmt.Sprintf("%s/SubscribeTopic", clientID)
+	messages, err := s.eventStream.SubscribeTopics(sctx, streamClientID)
+	if err != nil {
+		cancel()
+		return err
+	}
+	defer func() {
+		logger.Debug("unsubscribing client")
+		cancel()
+		if unsubErr := s.Unsubscribe(streamClientID); unsubErr != nil {
+			logger.Error("unsubscribe failed", "error", unsubErr)
+		}
+	}()
+
+	for msg := range messages {
+		msgNamespace, topic := splitNamespacedKey(msg.Topic)
+		if msgNamespace != namespace || !topicMatches(in.Patterns, topic) {
+			continue
+		}
+
+		if e := stream.Send(
+			&pb.TopicMessage{
+				Topic:    topic,
+				Payload:  msg.Payload,
+				ClientId: msg.ClientID,
+				Time:     timestamppb.New(msg.Time),
+			},
+		); e != nil {
+			logger.Warn("error sending message", "error", e, "message", msg)
+			return e
+		}
+		logger.Debug("sent topic message", "message", msg)
+	}
+
+	logger.Debug("stream finished", "client_id", clientID)
+	return nil
+}
+
+// topicMatches returns true if the topic matches any of the given glob
+// patterns, or if no patterns were given
+func topicMatches(patterns []string, topic string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, topic); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,357 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/arcward/keyquarry/api"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Txn applies the operations in the given request atomically, if all of
+// its guards are met. Every operation is validated before any are applied,
+// so either all of them succeed, or none are applied. Events emitted by
+// the operations share a transaction ID, and are delivered together.
+func (s *Server) Txn(ctx context.Context, in *pb.TxnRequest) (
+	*pb.TxnResponse,
+	error,
+) {
+	span := trace.SpanFromContext(ctx)
+
+	logger := s.requestLogger(ctx)
+	clientID := s.ClientID(ctx)
+	logger.Info(
+		"transaction request",
+		slog.Int("guards", len(in.Guards)),
+		slog.Int("ops", len(in.Ops)),
+	)
+
+	s.cfgMu.RLock()
+	cfg := *s.cfg
+	s.cfgMu.RUnlock()
+	if cfg.Readonly {
+		return nil, ErrReadOnlyServer
+	}
+
+	if len(in.Ops) == 0 {
+		return nil, ErrEmptyTxn
+	}
+
+	namespace := s.Namespace(ctx)
+	cfg = cfg.forNamespace(namespace)
+
+	ops := make([]*txnOp, 0, len(in.Ops))
+	keys := make([]string, 0, len(in.Ops))
+	for i, op := range in.Ops {
+		top, err := validateTxnOp(cfg, op)
+		if err != nil {
+			return nil, prefixError(fmt.Sprintf("op %d", i), err)
+		}
+		top.setNamespace(namespace)
+		if sliceContains(keys, top.key) {
+			return nil, KQError{
+				Message: fmt.Sprintf(
+					"op %d: duplicate key '%s'",
+					i,
+					keyName(top.key),
+				),
+				Code: codes.InvalidArgument,
+			}
+		}
+		keys = append(keys, top.key)
+		ops = append(ops, top)
+	}
+	span.SetAttributes(attribute.StringSlice("keys", keys))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, guard := range in.Guards {
+		guard.Key = namespacedKey(namespace, guard.Key)
+		if err := s.checkTxnGuard(clientID, guard); err != nil {
+			logger.Info("transaction guard failed", "guard", i, "error", err)
+			return nil, prefixError(fmt.Sprintf("guard %d", i), err)
+		}
+	}
+
+	var newKeys uint64
+	for i, op := range ops {
+		if err := s.checkTxnOp(cfg, clientID, op); err != nil {
+			logger.Info("transaction op failed", "op", i, "error", err)
+			return nil, prefixError(fmt.Sprintf("op %d", i), err)
+		}
+		if _, exists := s.store[op.key]; !exists && op.delete == nil {
+			newKeys++
+		}
+	}
+	maxKeys := cfg.MaxNumberOfKeys
+	if maxKeys > 0 && s.numKeys.Load()+newKeys > maxKeys {
+		return nil, ErrMaxKeysReached
+	}
+	if err := s.checkNamespaceMaxKeys(cfg, namespace, newKeys); err != nil {
+		return nil, err
+	}
+
+	txn := &txnEvents{
+		id:       uuid.NewString(),
+		clientID: clientID,
+		keys:     keys,
+	}
+	logger = logger.With("txn_id", txn.id)
+
+	s.txn.Store(txn)
+	defer func() {
+		s.txn.Store(nil)
+		s.emitGroup(txn.events)
+	}()
+
+	resp := &pb.TxnResponse{
+		TxnId:   txn.id,
+		Results: make([]*pb.TxnOpResult, 0, len(ops)),
+	}
+	for i, op := range ops {
+		result, err := s.applyTxnOp(logger, cfg, clientID, op)
+		if err != nil {
+			// Every op was checked above while holding mu, so this
+			// shouldn't happen
+			logger.Error(
+				"transaction op failed after validation",
+				"op", i,
+				"error", err,
+			)
+			return nil, KQError{
+				Message: fmt.Sprintf("op %d: %s", i, err.Error()),
+				Code:    codes.Internal,
+			}
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	logger.Info("applied transaction", "keys", keys)
+	return resp, nil
+}
+
+// txnOp is a validated operation from a [pb.TxnRequest]. Only one of
+// set, delete or lock will be set.
+type txnOp struct {
+	key string
+
+	set          *pb.KeyValue
+	lockDuration *time.Duration
+	lifespan     *time.Duration
+
+	delete *pb.DeleteRequest
+
+	lock    *pb.LockRequest
+	lockFor time.Duration
+}
+
+// validateTxnOp validates the given operation the same way as the
+// equivalent Set, Delete or Lock request
+func validateTxnOp(cfg Config, op *pb.TxnOp) (*txnOp, error) {
+	var err error
+	top := &txnOp{}
+
+	switch v := op.Op.(type) {
+	case *pb.TxnOp_Set:
+		top.key = v.Set.Key
+		top.set = v.Set
+		top.lockDuration, top.lifespan, err = validateSetRequest(cfg, v.Set)
+	case *pb.TxnOp_Delete:
+		top.key = v.Delete.Key
+		top.delete = v.Delete
+		if v.Delete.Key == "" {
+			return nil, ErrEmptyKey
+		}
+		err = validateDeleteRequest(v.Delete)
+	case *pb.TxnOp_Lock:
+		top.key = v.Lock.Key
+		top.lock = v.Lock
+		if v.Lock.WaitTimeout != nil {
+			return nil, KQError{
+				Message: "wait_timeout can't be used in a transaction",
+				Code:    codes.InvalidArgument,
+			}
+		}
+		top.lockFor, err = validateLockRequest(cfg, v.Lock)
+	default:
+		return nil, KQError{
+			Message: "missing operation",
+			Code:    codes.InvalidArgument,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return top, nil
+}
+
+// setNamespace replaces the operation's key with its internal key in
+// the given namespace
+func (op *txnOp) setNamespace(namespace string) {
+	op.key = namespacedKey(namespace, op.key)
+	switch {
+	case op.set != nil:
+		op.set.Key = op.key
+	case op.delete != nil:
+		op.delete.Key = op.key
+	default:
+		op.lock.Key = op.key
+	}
+}
+
+// checkTxnOp returns an error if the given operation can't currently be
+// applied. Server.mu must be held by the caller.
+func (s *Server) checkTxnOp(cfg Config, clientID string, op *txnOp) error {
+	switch {
+	case op.set != nil:
+		if err := s.checkSet(cfg, clientID, op.set); err != nil {
+			return err
+		}
+		return s.checkSchema(op.set.Key, op.set.Value)
+	case op.delete != nil:
+		return s.checkDelete(clientID, op.delete)
+	default:
+		return s.checkLock(cfg, clientID, op.lock)
+	}
+}
+
+// applyTxnOp applies the given operation. Server.mu must be held by
+// the caller.
+func (s *Server) applyTxnOp(
+	logger *slog.Logger,
+	cfg Config,
+	clientID string,
+	op *txnOp,
+) (*pb.TxnOpResult, error) {
+	switch {
+	case op.set != nil:
+		rv, err := s.setKey(
+			logger,
+			cfg,
+			clientID,
+			op.set,
+			op.lockDuration,
+			op.lifespan,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.TxnOpResult{Result: &pb.TxnOpResult_Set{Set: rv}}, nil
+	case op.delete != nil:
+		rv, err := s.deleteKeyValue(logger, cfg, clientID, op.delete)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.TxnOpResult{Result: &pb.TxnOpResult_Delete{Delete: rv}}, nil
+	default:
+		rv, err := s.lockKey(logger, cfg, clientID, op.lock, op.lockFor)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.TxnOpResult{Result: &pb.TxnOpResult_Lock{Lock: rv}}, nil
+	}
+}
+
+// checkTxnGuard returns an error if the given guard's condition isn't
+// met. Server.mu must be held by the caller.
+func (s *Server) checkTxnGuard(clientID string, guard *pb.TxnGuard) error {
+	kvInfo := s.store[guard.Key]
+
+	switch guard.Check {
+	case pb.TxnCheck_KEY_EXISTS:
+		return checkWriteCondition(
+			guard.Key,
+			kvInfo,
+			pb.WriteMode_UPDATE_ONLY,
+			nil,
+			nil,
+		)
+	case pb.TxnCheck_KEY_NOT_EXISTS:
+		return checkWriteCondition(
+			guard.Key,
+			kvInfo,
+			pb.WriteMode_CREATE_ONLY,
+			nil,
+			nil,
+		)
+	case pb.TxnCheck_VERSION_EQUALS:
+		return checkWriteCondition(
+			guard.Key,
+			kvInfo,
+			pb.WriteMode_IF_VERSION_MATCHES,
+			&guard.Version,
+			nil,
+		)
+	case pb.TxnCheck_LOCKED_BY_ME:
+		s.lockMu.RLock()
+		keyLock, locked := s.locks[guard.Key]
+		s.lockMu.RUnlock()
+		if !locked || keyLock.ClientID != clientID {
+			return KQError{
+				Message: "key is not locked by this client",
+				Code:    codes.FailedPrecondition,
+			}
+		}
+		return nil
+	default:
+		return KQError{
+			Message: "invalid guard check",
+			Code:    codes.InvalidArgument,
+		}
+	}
+}
+
+// txnEvents collects the events emitted while a transaction is being
+// applied, so they can be sent together once it's complete.
+type txnEvents struct {
+	// id is the transaction ID, attached to each event
+	id string
+
+	// clientID is the client that requested the transaction
+	clientID string
+
+	// keys are the keys targeted by the transaction's operations
+	keys []string
+
+	events []Event
+	mu     sync.Mutex
+}
+
+// add attaches the transaction ID to the given event and collects it,
+// if the event belongs to the transaction, returning true. Otherwise,
+// it returns false and the event should be emitted as usual.
+//
+// Events belong to the transaction if they were triggered by the same
+// client, for one of its keys. While a transaction is being applied,
+// Server.mu is held, so the only other events which may be emitted
+// concurrently are [Accessed] events from reads, and events triggered
+// internally (by reapers, etc.), neither of which are collected.
+func (t *txnEvents) add(ev Event) bool {
+	switch {
+	case ev.ClientID != t.clientID,
+		ev.Event == Accessed,
+		!sliceContains(t.keys, ev.Key):
+		return false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ev.TxnID = t.id
+	t.events = append(t.events, ev)
+	return true
+}
+
+// prefixError returns err with the given prefix added to its message,
+// preserving its gRPC status code and details
+func prefixError(prefix string, err error) error {
+	st := status.Convert(err).Proto()
+	st.Message = fmt.Sprintf("%s: %s", prefix, st.Message)
+	return status.FromProto(st).Err()
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	pb "github.com/arcward/keyquarry/api"
+	"time"
+)
+
+// typedKeyForWrite returns the key to be modified by an operation on
+// values of the given type, after checking the client can write to it.
+// If the key doesn't exist and create is true, it's created with an empty
+// value of that type, and isNew is true. Otherwise, ErrKeyNotFound is
+// returned. If the key has a value of another type, ErrWrongType is
+// returned. Server.mu must be held by the caller.
+func (s *Server) typedKeyForWrite(
+	cfg Config,
+	clientID string,
+	key string,
+	valueType pb.ValueType,
+	create bool,
+) (kvInfo *keyValue, isNew bool, err error) {
+	kvInfo, exists := s.store[key]
+	if !exists && !create {
+		return nil, false, ErrKeyNotFound
+	}
+	if err = s.checkSet(cfg, clientID, &pb.KeyValue{Key: key}); err != nil {
+		return nil, false, err
+	}
+
+	if exists {
+		kvInfo.mu.RLock()
+		currentType := kvInfo.Type
+		kvInfo.mu.RUnlock()
+		if currentType != valueType {
+			return nil, false, ErrWrongType
+		}
+		return kvInfo, false, nil
+	}
+
+	kvInfo = s.createEmptyKey(cfg, key, clientID, valueType)
+	s.logger.Info("created key", kvLogKey, kvInfo)
+	return kvInfo, true, nil
+}
+
+// typedKeyForRead returns the key to be read by an operation on values
+// of the given type. ErrKeyNotFound is returned if it doesn't exist,
+// ErrLocked if another client holds an exclusive lock on it, and
+// ErrWrongType if it has a value of another type. Server.mu must be held
+// by the caller.
+func (s *Server) typedKeyForRead(
+	clientID string,
+	key string,
+	valueType pb.ValueType,
+) (*keyValue, error) {
+	kvInfo := s.getKey(key)
+	if kvInfo == nil {
+		return nil, ErrKeyNotFound
+	}
+
+	s.lockMu.RLock()
+	keyLock, locked := s.locks[key]
+	s.lockMu.RUnlock()
+	if locked && keyLock.ClientID != clientID {
+		return nil, ErrLocked
+	}
+
+	kvInfo.mu.RLock()
+	defer kvInfo.mu.RUnlock()
+	if kvInfo.Type != valueType {
+		return nil, ErrWrongType
+	}
+	return kvInfo, nil
+}
+
+// updateValue records a change to a value of a type other than STRING,
+// given its new total size. Unless the key was just created, its version
+// is incremented and an [Updated] event is emitted, with the names of any
+// fields changed (for HASH values). Server.mu and keyValue.mu must be
+// held by the caller.
+func (s *Server) updateValue(
+	kvInfo *keyValue,
+	clientID string,
+	size uint64,
+	isNew bool,
+	fields ...string,
+) {
+	s.totalSize.Add(^(kvInfo.Size - 1))
+	s.totalSize.Add(size)
+	// Values of other types aren't compressed, and are counted at their
+	// original size even if their elements are encrypted
+	s.storedSize.Add(^(kvInfo.Size - 1))
+	s.storedSize.Add(size)
+	s.resizeNamespaceKey(kvInfo.Key, kvInfo.Size, size)
+	kvInfo.Size = size
+	if isNew {
+		return
+	}
+
+	now := time.Now()
+	kvInfo.Version++
+	kvInfo.Updated = now
+	s.emitEvent(
+		Event{
+			Key:      kvInfo.Key,
+			Event:    Updated,
+			Time:     now,
+			ClientID: clientID,
+			Fields:   fields,
+		},
+	)
+}